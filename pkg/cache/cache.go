@@ -0,0 +1,430 @@
+package cache
+
+import (
+	"fmt"
+	"iter"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+// Cache is a generic in-memory cache with least recently used eviction and optional expiration of entries.
+//
+// All operations are O(1). Expired entries are removed lazily, when they are accessed or when they are the least recently used
+// entry evicted to make room for a new one. When the cache is full, the least recently used entry is evicted even if
+// other entries have already expired, use DeleteExpired to remove the expired entries eagerly.
+//
+// Cache is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries map[K]*entry[K, V]
+	// head is the most recently used entry, tail is the least recently used one
+	head *entry[K, V]
+	tail *entry[K, V]
+
+	loads map[K]*load[V]
+
+	maxSize int
+	ttl     time.Duration
+	now     func() time.Time
+	onEvict func(key K, value V, reason EvictionReason)
+
+	stats stats
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+	prev      *entry[K, V]
+	next      *entry[K, V]
+}
+
+type load[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type eviction[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictionReason
+}
+
+// New creates a new Cache configured with the given options.
+func New[K comparable, V any](opts ...func(*Options[K, V])) *Cache[K, V] {
+	options := to.OptionsWithDefault(Options[K, V]{
+		now: time.Now,
+	}, opts...)
+
+	return &Cache[K, V]{
+		entries: make(map[K]*entry[K, V]),
+		loads:   make(map[K]*load[V]),
+		maxSize: options.maxSize,
+		ttl:     options.ttl,
+		now:     options.now,
+		onEvict: options.onEvict,
+	}
+}
+
+// Get returns the value for the key and true if the key is present and not expired,
+// otherwise it returns zero value and false.
+// It marks the entry as the most recently used one.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	e, evicted := c.lookup(key)
+	var value V
+	if e != nil {
+		c.moveToFront(e)
+		// the value is read with the lock held, as Set updates the value of the existing entry in place
+		value = e.value
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+
+	if e == nil {
+		c.stats.misses.Add(1)
+		return value, false
+	}
+	c.stats.hits.Add(1)
+	return value, true
+}
+
+// Peek returns the value for the key and true if the key is present and not expired,
+// otherwise it returns zero value and false.
+// Unlike Get, it doesn't change the recency of the entry and doesn't update hits and misses counters.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	e, evicted := c.lookup(key)
+	var value V
+	if e != nil {
+		value = e.value
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return value, e != nil
+}
+
+// Contains returns true if the key is present and not expired.
+// It doesn't change the recency of the entry.
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.Peek(key)
+	return ok
+}
+
+// Set sets the value for the key with the default TTL of the cache, and marks it as the most recently used entry.
+// If the cache exceeds its max size, the least recently used entry is evicted.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL sets the value for the key with the given TTL, and marks it as the most recently used entry.
+// TTL lower or equal to zero means that the entry never expires.
+// If the cache exceeds its max size, the least recently used entry is evicted.
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	evicted := c.set(key, value, ttl)
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// GetOrLoad returns the value for the key if present and not expired,
+// otherwise it loads the value with the loader function, stores it with the default TTL and returns it.
+//
+// Concurrent calls for the same key are deduplicated: only one loader is executed,
+// and all the callers receive its result. Errors returned by the loader are not cached.
+// If the loader panics, the panic is propagated to the caller which executed it, and the other callers receive an error.
+func (c *Cache[K, V]) GetOrLoad(key K, loader func(key K) (V, error)) (V, error) {
+	c.mu.Lock()
+	e, evicted := c.lookup(key)
+	if e != nil {
+		c.moveToFront(e)
+		value := e.value
+		c.mu.Unlock()
+
+		c.notify(evicted)
+		c.stats.hits.Add(1)
+		return value, nil
+	}
+	c.stats.misses.Add(1)
+
+	if inFlight, ok := c.loads[key]; ok {
+		c.mu.Unlock()
+
+		c.notify(evicted)
+		<-inFlight.done
+		return inFlight.value, inFlight.err
+	}
+
+	l := &load[V]{done: make(chan struct{})}
+	c.loads[key] = l
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	c.runLoad(key, l, loader)
+	return l.value, l.err
+}
+
+func (c *Cache[K, V]) runLoad(key K, l *load[V], loader func(key K) (V, error)) {
+	var evicted []eviction[K, V]
+	defer func() {
+		c.mu.Lock()
+		delete(c.loads, key)
+		if l.err == nil {
+			evicted = c.set(key, l.value, c.ttl)
+		} else {
+			c.stats.loadErrors.Add(1)
+		}
+		c.mu.Unlock()
+
+		close(l.done)
+		c.notify(evicted)
+	}()
+
+	c.stats.loads.Add(1)
+	// reported to the waiting callers if the loader panics, the panic itself is propagated to this caller
+	l.err = fmt.Errorf("cache: loader for key %v panicked", key)
+	l.value, l.err = loader(key)
+}
+
+// Delete removes the key from the cache.
+// Returns true if the key was present and not expired.
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	e, evicted := c.lookup(key)
+	if e != nil {
+		c.remove(e)
+		evicted = append(evicted, eviction[K, V]{key: e.key, value: e.value, reason: EvictionReasonDeleted})
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return e != nil
+}
+
+// DeleteExpired removes all expired entries from the cache.
+func (c *Cache[K, V]) DeleteExpired() {
+	c.mu.Lock()
+	var evicted []eviction[K, V]
+	now := c.now()
+	for e := c.head; e != nil; {
+		next := e.next
+		if e.isExpired(now) {
+			evicted = append(evicted, c.expire(e))
+		}
+		e = next
+	}
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Clear removes all entries from the cache.
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	var evicted []eviction[K, V]
+	if c.onEvict != nil {
+		evicted = make([]eviction[K, V], 0, len(c.entries))
+		for e := c.head; e != nil; e = e.next {
+			evicted = append(evicted, eviction[K, V]{key: e.key, value: e.value, reason: EvictionReasonDeleted})
+		}
+	}
+	c.entries = make(map[K]*entry[K, V])
+	c.head = nil
+	c.tail = nil
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Len returns the number of entries in the cache, including the expired entries that haven't been removed yet.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// All returns an iter.Seq2 over a snapshot of the not expired entries of the cache,
+// from the most recently used to the least recently used.
+// The iteration doesn't change the recency of the entries, and the cache can be safely modified during the iteration.
+func (c *Cache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		c.mu.Lock()
+		now := c.now()
+		snapshot := make([]*entry[K, V], 0, len(c.entries))
+		for e := c.head; e != nil; e = e.next {
+			if !e.isExpired(now) {
+				snapshot = append(snapshot, &entry[K, V]{key: e.key, value: e.value})
+			}
+		}
+		c.mu.Unlock()
+
+		for _, e := range snapshot {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Stats returns the current values of the cache counters.
+func (c *Cache[K, V]) Stats() Stats {
+	return c.stats.snapshot()
+}
+
+// lookup returns the entry for the key, or nil if it's absent or expired, the expired entry is removed.
+// It must be called with the lock held.
+func (c *Cache[K, V]) lookup(key K) (*entry[K, V], []eviction[K, V]) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	if e.isExpired(c.now()) {
+		return nil, []eviction[K, V]{c.expire(e)}
+	}
+	return e, nil
+}
+
+// set must be called with the lock held.
+func (c *Cache[K, V]) set(key K, value V, ttl time.Duration) []eviction[K, V] {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.now().Add(ttl)
+	}
+
+	if e, ok := c.entries[key]; ok {
+		e.value = value
+		e.expiresAt = expiresAt
+		c.moveToFront(e)
+		return nil
+	}
+
+	e := &entry[K, V]{key: key, value: value, expiresAt: expiresAt}
+	c.entries[key] = e
+	c.pushFront(e)
+
+	if c.maxSize <= 0 || len(c.entries) <= c.maxSize {
+		return nil
+	}
+
+	lru := c.tail
+	c.remove(lru)
+	if lru.isExpired(c.now()) {
+		c.stats.expirations.Add(1)
+		return []eviction[K, V]{{key: lru.key, value: lru.value, reason: EvictionReasonExpired}}
+	}
+	c.stats.evictions.Add(1)
+	return []eviction[K, V]{{key: lru.key, value: lru.value, reason: EvictionReasonCapacity}}
+}
+
+// expire must be called with the lock held.
+func (c *Cache[K, V]) expire(e *entry[K, V]) eviction[K, V] {
+	c.remove(e)
+	c.stats.expirations.Add(1)
+	return eviction[K, V]{key: e.key, value: e.value, reason: EvictionReasonExpired}
+}
+
+func (c *Cache[K, V]) notify(evicted []eviction[K, V]) {
+	if c.onEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		c.onEvict(e.key, e.value, e.reason)
+	}
+}
+
+func (c *Cache[K, V]) pushFront(e *entry[K, V]) {
+	e.prev = nil
+	e.next = c.head
+	if c.head != nil {
+		c.head.prev = e
+	}
+	c.head = e
+	if c.tail == nil {
+		c.tail = e
+	}
+}
+
+func (c *Cache[K, V]) moveToFront(e *entry[K, V]) {
+	if c.head == e {
+		return
+	}
+	c.unlink(e)
+	c.pushFront(e)
+}
+
+func (c *Cache[K, V]) remove(e *entry[K, V]) {
+	c.unlink(e)
+	delete(c.entries, e.key)
+}
+
+func (c *Cache[K, V]) unlink(e *entry[K, V]) {
+	if e.prev == nil {
+		c.head = e.next
+	} else {
+		e.prev.next = e.next
+	}
+	if e.next == nil {
+		c.tail = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+	e.prev = nil
+	e.next = nil
+}
+
+func (e *entry[K, V]) isExpired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// Stats contains the counters of the cache operations.
+type Stats struct {
+	// Hits is the number of Get and GetOrLoad calls that found the value in the cache.
+	Hits uint64
+	// Misses is the number of Get and GetOrLoad calls that didn't find the value in the cache.
+	Misses uint64
+	// Loads is the number of executed loaders.
+	Loads uint64
+	// LoadErrors is the number of loaders that returned an error or panicked.
+	LoadErrors uint64
+	// Evictions is the number of entries evicted because the cache exceeded its max size.
+	Evictions uint64
+	// Expirations is the number of entries removed because their TTL has passed.
+	Expirations uint64
+}
+
+// HitRatio returns the ratio of hits to all lookups, or 0 if there were no lookups.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type stats struct {
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	loads       atomic.Uint64
+	loadErrors  atomic.Uint64
+	evictions   atomic.Uint64
+	expirations atomic.Uint64
+}
+
+func (s *stats) snapshot() Stats {
+	return Stats{
+		Hits:        s.hits.Load(),
+		Misses:      s.misses.Load(),
+		Loads:       s.loads.Load(),
+		LoadErrors:  s.loadErrors.Load(),
+		Evictions:   s.evictions.Load(),
+		Expirations: s.expirations.Load(),
+	}
+}
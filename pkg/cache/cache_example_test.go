@@ -0,0 +1,99 @@
+package cache_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/cache"
+)
+
+func ExampleNew() {
+	c := cache.New[string, int](cache.WithMaxSize[string, int](2))
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")    // "a" becomes the most recently used entry
+	c.Set("c", 3) // evicts "b" as the least recently used entry
+
+	for k, v := range c.All() {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// c 3
+	// a 1
+}
+
+func ExampleWithTTL() {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	c := cache.New[string, string](cache.WithTTL[string, string](time.Minute), cache.WithClock[string, string](clock))
+	c.Set("session", "token")
+	c.SetWithTTL("remember-me", "long-token", time.Hour)
+
+	now = now.Add(2 * time.Minute)
+
+	_, ok := c.Get("session")
+	fmt.Println("session present:", ok)
+
+	_, ok = c.Get("remember-me")
+	fmt.Println("remember-me present:", ok)
+	// Output:
+	// session present: false
+	// remember-me present: true
+}
+
+func ExampleWithEvictionCallback() {
+	c := cache.New[string, int](
+		cache.WithMaxSize[string, int](1),
+		cache.WithEvictionCallback(func(key string, value int, reason cache.EvictionReason) {
+			fmt.Printf("evicted %s=%d (%s)\n", key, value, reason)
+		}),
+	)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Delete("b")
+	// Output:
+	// evicted a=1 (capacity)
+	// evicted b=2 (deleted)
+}
+
+func ExampleCache_GetOrLoad() {
+	c := cache.New[int, string]()
+
+	loader := func(id int) (string, error) {
+		fmt.Println("loading user", id)
+		if id < 0 {
+			return "", errors.New("invalid id")
+		}
+		return fmt.Sprintf("user-%d", id), nil
+	}
+
+	fmt.Println(c.GetOrLoad(1, loader))
+	fmt.Println(c.GetOrLoad(1, loader))
+	fmt.Println(c.GetOrLoad(-1, loader))
+	// Output:
+	// loading user 1
+	// user-1 <nil>
+	// user-1 <nil>
+	// loading user -1
+	//  invalid id
+}
+
+func ExampleCache_Stats() {
+	c := cache.New[string, int]()
+	c.Set("a", 1)
+
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+
+	stats := c.Stats()
+	fmt.Println(stats.Hits, stats.Misses)
+	fmt.Printf("%.2f\n", stats.HitRatio())
+	// Output:
+	// 2 1
+	// 0.67
+}
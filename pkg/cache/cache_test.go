@@ -0,0 +1,151 @@
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/cache"
+)
+
+func TestGetOrLoadDeduplicatesConcurrentLoads(t *testing.T) {
+	c := cache.New[string, int]()
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	loader := func(string) (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	const callers = 10
+	var started, finished sync.WaitGroup
+	results := make([]int, callers)
+	for i := range callers {
+		started.Add(1)
+		finished.Add(1)
+		go func() {
+			defer finished.Done()
+			started.Done()
+			v, err := c.GetOrLoad("key", loader)
+			assert.NoError(t, err)
+			results[i] = v
+		}()
+	}
+	started.Wait()
+	// give the goroutines a chance to join the in-flight load
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	finished.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, v := range results {
+		assert.Equal(t, 42, v)
+	}
+	assert.Equal(t, uint64(1), c.Stats().Loads)
+}
+
+func TestGetOrLoadDoesNotCacheErrors(t *testing.T) {
+	c := cache.New[string, int]()
+	failure := errors.New("failure")
+
+	_, err := c.GetOrLoad("key", func(string) (int, error) { return 0, failure })
+	require.ErrorIs(t, err, failure)
+	assert.False(t, c.Contains("key"))
+
+	v, err := c.GetOrLoad("key", func(string) (int, error) { return 1, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, uint64(1), c.Stats().LoadErrors)
+}
+
+func TestGetOrLoadPropagatesPanicAndReleasesKey(t *testing.T) {
+	c := cache.New[string, int]()
+
+	assert.Panics(t, func() {
+		_, _ = c.GetOrLoad("key", func(string) (int, error) { panic("boom") })
+	})
+
+	v, err := c.GetOrLoad("key", func(string) (int, error) { return 1, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, uint64(1), c.Stats().LoadErrors)
+}
+
+func TestExpiredEntriesAreEvictedWithReason(t *testing.T) {
+	now := time.Now()
+	var reasons []cache.EvictionReason
+	c := cache.New[string, int](
+		cache.WithClock[string, int](func() time.Time { return now }),
+		cache.WithTTL[string, int](time.Second),
+		cache.WithEvictionCallback(func(_ string, _ int, reason cache.EvictionReason) {
+			reasons = append(reasons, reason)
+		}),
+	)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.SetWithTTL("c", 3, 0)
+	now = now.Add(time.Second)
+
+	c.DeleteExpired()
+
+	assert.Equal(t, 1, c.Len())
+	assert.Equal(t, []cache.EvictionReason{cache.EvictionReasonExpired, cache.EvictionReasonExpired}, reasons)
+	assert.Equal(t, uint64(2), c.Stats().Expirations)
+}
+
+func TestFullCacheEvictsLeastRecentlyUsedEntryEvenIfOtherEntryExpired(t *testing.T) {
+	now := time.Now()
+	evicted := map[string]cache.EvictionReason{}
+	c := cache.New[string, int](
+		cache.WithMaxSize[string, int](2),
+		cache.WithClock[string, int](func() time.Time { return now }),
+		cache.WithEvictionCallback(func(key string, _ int, reason cache.EvictionReason) {
+			evicted[key] = reason
+		}),
+	)
+
+	c.Set("live", 1)
+	c.SetWithTTL("expiring", 2, time.Second)
+	now = now.Add(time.Second)
+	c.Set("new", 3)
+
+	assert.Equal(t, map[string]cache.EvictionReason{"live": cache.EvictionReasonCapacity}, evicted)
+	assert.Equal(t, 2, c.Len())
+
+	c.DeleteExpired()
+
+	assert.Equal(t, cache.EvictionReasonExpired, evicted["expiring"])
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	c := cache.New[int, int](cache.WithMaxSize[int, int](100))
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				key := (g*1000 + i) % 150
+				c.Set(key, i)
+				c.Get(key)
+				_, _ = c.GetOrLoad(key+1, func(k int) (int, error) { return k, nil })
+				for range c.All() {
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, c.Len(), 100)
+}
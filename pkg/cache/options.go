@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"time"
+)
+
+// EvictionReason describes why an entry was removed from the cache.
+type EvictionReason int
+
+const (
+	// EvictionReasonCapacity means that the entry was the least recently used one, when the cache exceeded its max size.
+	EvictionReasonCapacity EvictionReason = iota + 1
+	// EvictionReasonExpired means that the entry's TTL has passed.
+	EvictionReasonExpired
+	// EvictionReasonDeleted means that the entry was removed with Delete or Clear.
+	EvictionReasonDeleted
+)
+
+// String returns the name of the eviction reason.
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionReasonCapacity:
+		return "capacity"
+	case EvictionReasonExpired:
+		return "expired"
+	case EvictionReasonDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// Options is a set of options for the cache with keys of type K and values of type V.
+type Options[K comparable, V any] struct {
+	maxSize int
+	ttl     time.Duration
+	now     func() time.Time
+	onEvict func(key K, value V, reason EvictionReason)
+}
+
+// WithMaxSize sets the maximum number of entries in the cache,
+// when it is exceeded, the least recently used entry is evicted.
+// Values lower or equal to zero mean no limit, which is the default.
+func WithMaxSize[K comparable, V any](maxSize int) func(*Options[K, V]) {
+	return func(options *Options[K, V]) {
+		options.maxSize = maxSize
+	}
+}
+
+// WithTTL sets the default time to live of the entries added to the cache.
+// Values lower or equal to zero mean that entries never expire, which is the default.
+func WithTTL[K comparable, V any](ttl time.Duration) func(*Options[K, V]) {
+	return func(options *Options[K, V]) {
+		options.ttl = ttl
+	}
+}
+
+// WithClock sets the function returning the current time, used for expiration of the entries.
+// It is useful for testing, by default time.Now is used.
+func WithClock[K comparable, V any](now func() time.Time) func(*Options[K, V]) {
+	return func(options *Options[K, V]) {
+		options.now = now
+	}
+}
+
+// WithEvictionCallback sets the function called when an entry is removed from the cache.
+// The callback is called synchronously, after the cache lock has been released, so it can safely use the cache.
+func WithEvictionCallback[K comparable, V any](onEvict func(key K, value V, reason EvictionReason)) func(*Options[K, V]) {
+	return func(options *Options[K, V]) {
+		options.onEvict = onEvict
+	}
+}
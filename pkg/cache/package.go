@@ -0,0 +1,6 @@
+// Package cache provides a generic, thread-safe, in-memory cache
+// with least recently used (LRU) eviction and optional expiration of entries (TTL).
+//
+// It is designed for memoizing lookups in services: concurrent loads of the same key are deduplicated,
+// evictions can be observed with a callback, and the cache exposes counters useful for monitoring its efficiency.
+package cache
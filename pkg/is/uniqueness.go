@@ -1,6 +1,10 @@
 package is
 
-import "iter"
+import (
+	"iter"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
 
 // Unique returns true if all elements in the sequence are unique,
 // and false if there are any duplicates.
@@ -20,12 +24,12 @@ func Unique[E comparable, Collection ~[]E | iter.Seq[E]](collection Collection)
 // The sequence must provide elements of a comparable type.
 // See: UniqueSeqBy if it doesn't have comparable elements.§
 func UniqueSeq[E comparable](seq iter.Seq[E]) bool {
-	seen := make(map[E]struct{})
+	seen := make(types.Set[E])
 	for v := range seq {
-		if _, ok := seen[v]; ok {
+		if seen.Contains(v) {
 			return false
 		}
-		seen[v] = struct{}{}
+		seen.Add(v)
 	}
 	return true
 }
@@ -33,12 +37,12 @@ func UniqueSeq[E comparable](seq iter.Seq[E]) bool {
 // UniqueSlice checks if all elements in the provided slice are unique. Returns true if unique, otherwise false.
 // See: UniqueSliceBy if it doesn't have comparable elements.
 func UniqueSlice[E comparable](slice []E) bool {
-	seen := make(map[E]struct{})
+	seen := make(types.Set[E])
 	for _, v := range slice {
-		if _, ok := seen[v]; ok {
+		if seen.Contains(v) {
 			return false
 		}
-		seen[v] = struct{}{}
+		seen.Add(v)
 	}
 	return true
 }
@@ -58,13 +62,13 @@ func UniqueBy[E any, Collection ~[]E | iter.Seq[E], K comparable](collection Col
 // UniqueSliceBy checks if all elements in the given slice are unique.
 // It returns true if all elements are distinct, otherwise false.
 func UniqueSliceBy[E any, K comparable](slice []E, key func(E) K) bool {
-	seen := make(map[K]struct{})
+	seen := make(types.Set[K])
 	for _, v := range slice {
 		k := key(v)
-		if _, ok := seen[k]; ok {
+		if seen.Contains(k) {
 			return false
 		}
-		seen[k] = struct{}{}
+		seen.Add(k)
 	}
 	return true
 }
@@ -72,13 +76,13 @@ func UniqueSliceBy[E any, K comparable](slice []E, key func(E) K) bool {
 // UniqueSeqBy checks if all elements in the given iter.Seq are unique.
 // It returns true if all elements are distinct, otherwise false.
 func UniqueSeqBy[E any, K comparable](seq iter.Seq[E], key func(E) K) bool {
-	seen := make(map[K]struct{})
+	seen := make(types.Set[K])
 	for v := range seq {
 		k := key(v)
-		if _, ok := seen[k]; ok {
+		if seen.Contains(k) {
 			return false
 		}
-		seen[k] = struct{}{}
+		seen.Add(k)
 	}
 	return true
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-softwarelab/common/pkg/is"
 	"github.com/go-softwarelab/common/pkg/to"
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 const valueNotPresentErrorMessage = "value is not present"
@@ -60,6 +61,17 @@ func OfPtr[E any](v *E) Value[E] {
 	return Value[E]{value: v}
 }
 
+// OfOK returns an optional with the given value if ok is true, otherwise it returns an empty optional.
+// It converts the results of functions following the "comma ok" idiom,
+// for example: optional.OfOK(lazy.Peek()) or optional.OfOK(orderedMap.Get(key)).
+func OfOK[E any](v E, ok bool) Value[E] {
+	if !ok {
+		return Value[E]{}
+	}
+
+	return Value[E]{value: &v}
+}
+
 // OfValue returns an optional for the given value.
 // If value is zero value, it returns an empty optional.
 // Otherwise, it returns non-empty optional with the given value.
@@ -82,6 +94,15 @@ func (o Value[V]) Or(other Value[V]) Value[V] {
 	return other
 }
 
+// Filter returns this optional if the value is present and satisfies the predicate, otherwise returns an empty optional.
+func (o Value[V]) Filter(predicate func(V) bool) Value[V] {
+	if o.IsPresent() && predicate(*o.value) {
+		return o
+	}
+
+	return Empty[V]()
+}
+
 // ShouldGet returns the value if present, otherwise returns the error ValueNotPresent.
 func (o Value[V]) ShouldGet() (V, error) {
 	if o.IsEmpty() {
@@ -179,6 +200,25 @@ func (o Value[V]) IsNotEmpty() bool {
 	return o.value != nil
 }
 
+// ToPtr returns a pointer to a copy of the value if present, otherwise returns nil.
+func (o Value[V]) ToPtr() *V {
+	if o.IsEmpty() {
+		return nil
+	}
+
+	v := *o.value
+	return &v
+}
+
+// ToResult returns a successful types.Result with the value if present, otherwise a failed one with ValueNotPresent error.
+func (o Value[V]) ToResult() *types.Result[V] {
+	if o.IsEmpty() {
+		return types.FailureResult[V](ValueNotPresent)
+	}
+
+	return types.SuccessResult(*o.value)
+}
+
 // Seq returns the sequence with yelded value if present, otherwise returns an empty sequence.
 func (o Value[V]) Seq() iter.Seq[V] {
 	return func(yield func(V) bool) {
@@ -0,0 +1,173 @@
+package optional
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+var (
+	_ json.Marshaler           = Value[any]{}
+	_ json.Unmarshaler         = (*Value[any])(nil)
+	_ encoding.TextMarshaler   = Value[any]{}
+	_ encoding.TextUnmarshaler = (*Value[any])(nil)
+	_ sql.Scanner              = (*Value[any])(nil)
+	_ driver.Valuer            = Value[any]{}
+)
+
+var jsonNull = []byte("null")
+
+// IsZero returns true if the value is not present.
+// It allows to use `omitzero` option of encoding/json to skip empty optional fields.
+func (o Value[V]) IsZero() bool {
+	return o.IsEmpty()
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// Empty value is marshaled as JSON null, present value is marshaled as the value itself.
+func (o Value[V]) MarshalJSON() ([]byte, error) {
+	if o.IsEmpty() {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(*o.value) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// JSON null is unmarshaled as an empty value, any other JSON value is unmarshaled as a present value.
+func (o *Value[V]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = Empty[V]()
+		return nil
+	}
+
+	var v V
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	*o = Some(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface.
+// Empty value is marshaled as an empty text.
+// Present value is marshaled with its own MarshalText if it implements encoding.TextMarshaler,
+// otherwise strings, numbers and booleans are formatted in their standard text form.
+func (o Value[V]) MarshalText() ([]byte, error) {
+	if o.IsEmpty() {
+		return []byte{}, nil
+	}
+
+	if tm, ok := any(*o.value).(encoding.TextMarshaler); ok {
+		return tm.MarshalText() //nolint:wrapcheck
+	}
+
+	v := reflect.ValueOf(*o.value)
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("optional: cannot marshal %T as text", *o.value)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+// Empty text is unmarshaled as an empty value (also for string values).
+// Otherwise, the value is unmarshaled with its own UnmarshalText if it implements encoding.TextUnmarshaler,
+// or parsed from the standard text form of strings, numbers and booleans.
+func (o *Value[V]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Empty[V]()
+		return nil
+	}
+
+	var result V
+	if tu, ok := any(&result).(encoding.TextUnmarshaler); ok {
+		if err := tu.UnmarshalText(text); err != nil {
+			return err //nolint:wrapcheck
+		}
+		*o = Some(result)
+		return nil
+	}
+
+	v := reflect.ValueOf(&result).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(string(text))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(string(text))
+		if err != nil {
+			return fmt.Errorf("optional: cannot unmarshal text into %T: %w", result, err)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("optional: cannot unmarshal text into %T: %w", result, err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("optional: cannot unmarshal text into %T: %w", result, err)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(string(text), v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("optional: cannot unmarshal text into %T: %w", result, err)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("optional: cannot unmarshal text into %T", result)
+	}
+
+	*o = Some(result)
+	return nil
+}
+
+// Scan implements sql.Scanner interface.
+// SQL NULL is scanned as an empty value, any other value is converted
+// with the same rules as database/sql uses for scanning into a variable of type V.
+func (o *Value[V]) Scan(src any) error {
+	var n sql.Null[V]
+	if err := n.Scan(src); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if n.Valid {
+		*o = Some(n.V)
+	} else {
+		*o = Empty[V]()
+	}
+	return nil
+}
+
+// Value implements driver.Valuer interface.
+// Empty value is stored as SQL NULL, present value is converted to driver.Value
+// with its own Value method if it implements driver.Valuer, or with the default database/sql conversion otherwise.
+func (o Value[V]) Value() (driver.Value, error) {
+	if o.IsEmpty() {
+		return nil, nil
+	}
+
+	if valuer, ok := any(*o.value).(driver.Valuer); ok {
+		return valuer.Value() //nolint:wrapcheck
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(*o.value) //nolint:wrapcheck
+}
@@ -0,0 +1,85 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/optional"
+)
+
+func ExampleValue_MarshalJSON() {
+	type Person struct {
+		Name     string                 `json:"name"`
+		Age      optional.Value[int]    `json:"age"`
+		Nickname optional.Value[string] `json:"nickname,omitzero"`
+	}
+
+	withAge, _ := json.Marshal(Person{Name: "John", Age: optional.Of(30)})
+	fmt.Println(string(withAge))
+
+	withoutAge, _ := json.Marshal(Person{Name: "Jane"})
+	fmt.Println(string(withoutAge))
+
+	// Output:
+	// {"name":"John","age":30}
+	// {"name":"Jane","age":null}
+}
+
+func ExampleValue_UnmarshalJSON() {
+	type Person struct {
+		Name string              `json:"name"`
+		Age  optional.Value[int] `json:"age"`
+	}
+
+	var john Person
+	_ = json.Unmarshal([]byte(`{"name":"John","age":30}`), &john)
+	fmt.Println(john.Age.MustGet())
+
+	var jane Person
+	_ = json.Unmarshal([]byte(`{"name":"Jane","age":null}`), &jane)
+	fmt.Println(jane.Age.IsEmpty())
+
+	// Output:
+	// 30
+	// true
+}
+
+func ExampleValue_MarshalText() {
+	present, _ := optional.Of(42).MarshalText()
+	fmt.Printf("%q\n", present)
+
+	empty, _ := optional.Empty[int]().MarshalText()
+	fmt.Printf("%q\n", empty)
+
+	// Output:
+	// "42"
+	// ""
+}
+
+func ExampleValue_Scan() {
+	// Usually Scan is called by database/sql when reading a row into &value.
+	var value optional.Value[string]
+
+	_ = value.Scan([]byte("hello"))
+	fmt.Println(value.MustGet())
+
+	_ = value.Scan(nil)
+	fmt.Println(value.IsEmpty())
+
+	// Output:
+	// hello
+	// true
+}
+
+func ExampleValue_Value() {
+	// Usually Value is called by database/sql when passing optional as query argument.
+	present, _ := optional.Of(42).Value()
+	fmt.Printf("%T %v\n", present, present)
+
+	empty, _ := optional.Empty[int]().Value()
+	fmt.Println(empty)
+
+	// Output:
+	// int64 42
+	// <nil>
+}
@@ -0,0 +1,372 @@
+package optional_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City   string                   `json:"city"`
+	Street optional.Value[string]   `json:"street"`
+	Number optional.Value[int]      `json:"number,omitzero"`
+	Tags   optional.Value[[]string] `json:"tags"`
+}
+
+type user struct {
+	Name     string                          `json:"name"`
+	Age      optional.Value[int]             `json:"age"`
+	Address  optional.Value[address]         `json:"address,omitzero"`
+	Nickname *optional.Value[string]         `json:"nickname,omitempty"`
+	Created  optional.Value[time.Time]       `json:"created,omitzero"`
+	Labels   map[string]optional.Value[bool] `json:"labels,omitempty"`
+}
+
+func TestValueJSON(t *testing.T) {
+	marshalTests := map[string]struct {
+		value    any
+		expected string
+	}{
+		"empty value is null": {
+			value:    optional.Empty[int](),
+			expected: `null`,
+		},
+		"present value is the value itself": {
+			value:    optional.Of(42),
+			expected: `42`,
+		},
+		"present zero value is the value itself": {
+			value:    optional.Of(""),
+			expected: `""`,
+		},
+		"empty fields are null or omitted with omitzero": {
+			value:    user{Name: "John"},
+			expected: `{"name":"John","age":null}`,
+		},
+		"nested struct with present and empty fields": {
+			value: user{
+				Name:    "John",
+				Age:     optional.Of(30),
+				Address: optional.Of(address{City: "Warsaw", Number: optional.Of(0)}),
+			},
+			expected: `{"name":"John","age":30,"address":{"city":"Warsaw","street":null,"number":0,"tags":null}}`,
+		},
+		"pointer to optional field": {
+			value:    user{Name: "John", Nickname: ptrTo(optional.Of("Johnny"))},
+			expected: `{"name":"John","age":null,"nickname":"Johnny"}`,
+		},
+		"pointer to empty optional field": {
+			value:    user{Name: "John", Nickname: ptrTo(optional.Empty[string]())},
+			expected: `{"name":"John","age":null,"nickname":null}`,
+		},
+		"optional values in map": {
+			value:    map[string]optional.Value[bool]{"a": optional.Of(true), "b": optional.Empty[bool]()},
+			expected: `{"a":true,"b":null}`,
+		},
+	}
+	for name, test := range marshalTests {
+		t.Run("marshal: "+name, func(t *testing.T) {
+			// when:
+			data, err := json.Marshal(test.value)
+
+			// then:
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(data))
+		})
+	}
+
+	t.Run("unmarshal: null and missing fields are empty", func(t *testing.T) {
+		// given:
+		input := `{"name":"John","age":null}`
+
+		// when:
+		var u user
+		err := json.Unmarshal([]byte(input), &u)
+
+		// then:
+		require.NoError(t, err)
+		assert.True(t, u.Age.IsEmpty())
+		assert.True(t, u.Address.IsEmpty())
+		assert.Nil(t, u.Nickname)
+	})
+
+	t.Run("unmarshal: nested struct with present values", func(t *testing.T) {
+		// given:
+		input := `{"name":"John","age":0,"address":{"city":"Warsaw","street":"Main","tags":["a"]},"nickname":"Johnny","labels":{"x":false,"y":null}}`
+
+		// when:
+		var u user
+		err := json.Unmarshal([]byte(input), &u)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, 0, u.Age.MustGet())
+
+		addr := u.Address.MustGet()
+		assert.Equal(t, "Warsaw", addr.City)
+		assert.Equal(t, "Main", addr.Street.MustGet())
+		assert.True(t, addr.Number.IsEmpty())
+		assert.Equal(t, []string{"a"}, addr.Tags.MustGet())
+
+		require.NotNil(t, u.Nickname)
+		assert.Equal(t, "Johnny", u.Nickname.MustGet())
+
+		assert.False(t, u.Labels["x"].MustGet())
+		assert.True(t, u.Labels["y"].IsEmpty())
+	})
+
+	t.Run("unmarshal: null into pointer to optional leaves nil pointer", func(t *testing.T) {
+		// when:
+		var u user
+		err := json.Unmarshal([]byte(`{"nickname":null}`), &u)
+
+		// then:
+		require.NoError(t, err)
+		assert.Nil(t, u.Nickname)
+	})
+
+	t.Run("unmarshal: null resets previously present value", func(t *testing.T) {
+		// given:
+		value := optional.Of(1)
+
+		// when:
+		err := json.Unmarshal([]byte(`null`), &value)
+
+		// then:
+		require.NoError(t, err)
+		assert.True(t, value.IsEmpty())
+	})
+
+	t.Run("unmarshal: invalid value returns error", func(t *testing.T) {
+		// when:
+		var u user
+		err := json.Unmarshal([]byte(`{"age":"thirty"}`), &u)
+
+		// then:
+		require.Error(t, err)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// given:
+		original := user{
+			Name:    "John",
+			Age:     optional.Of(30),
+			Address: optional.Of(address{City: "Warsaw", Street: optional.Of("Main"), Number: optional.Of(1)}),
+			Created: optional.Of(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		}
+
+		// when:
+		data, err := json.Marshal(original)
+		require.NoError(t, err)
+
+		var decoded user
+		err = json.Unmarshal(data, &decoded)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, original.Age, decoded.Age)
+		assert.Equal(t, original.Address.MustGet().Street, decoded.Address.MustGet().Street)
+		assert.True(t, original.Created.MustGet().Equal(decoded.Created.MustGet()))
+	})
+}
+
+func TestValueText(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		tests := map[string]struct {
+			value    interface{ MarshalText() ([]byte, error) }
+			expected string
+		}{
+			"empty":               {value: optional.Empty[int](), expected: ""},
+			"string":              {value: optional.Of("abc"), expected: "abc"},
+			"int":                 {value: optional.Of(-12), expected: "-12"},
+			"uint":                {value: optional.Of(uint8(12)), expected: "12"},
+			"float":               {value: optional.Of(1.5), expected: "1.5"},
+			"bool":                {value: optional.Of(true), expected: "true"},
+			"text marshaler type": {value: optional.Of(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)), expected: "2024-01-02T03:04:05Z"},
+		}
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				// when:
+				text, err := test.value.MarshalText()
+
+				// then:
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, string(text))
+			})
+		}
+	})
+
+	t.Run("marshal unsupported type returns error", func(t *testing.T) {
+		// when:
+		_, err := optional.Of([]int{1}).MarshalText()
+
+		// then:
+		require.Error(t, err)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		// given:
+		var number optional.Value[int]
+		var text optional.Value[string]
+		var created optional.Value[time.Time]
+		var flag optional.Value[bool]
+
+		// when:
+		require.NoError(t, number.UnmarshalText([]byte("42")))
+		require.NoError(t, text.UnmarshalText([]byte("abc")))
+		require.NoError(t, created.UnmarshalText([]byte("2024-01-02T03:04:05Z")))
+		require.NoError(t, flag.UnmarshalText([]byte("false")))
+
+		// then:
+		assert.Equal(t, 42, number.MustGet())
+		assert.Equal(t, "abc", text.MustGet())
+		assert.Equal(t, 2024, created.MustGet().Year())
+		assert.False(t, flag.MustGet())
+	})
+
+	t.Run("unmarshal empty text is empty value", func(t *testing.T) {
+		// given:
+		text := optional.Of("abc")
+
+		// when:
+		err := text.UnmarshalText([]byte{})
+
+		// then:
+		require.NoError(t, err)
+		assert.True(t, text.IsEmpty())
+	})
+
+	t.Run("unmarshal invalid text returns error", func(t *testing.T) {
+		// when:
+		var number optional.Value[int]
+		err := number.UnmarshalText([]byte("abc"))
+
+		// then:
+		require.Error(t, err)
+		assert.True(t, number.IsEmpty())
+	})
+}
+
+func TestValueSQL(t *testing.T) {
+	t.Run("scan NULL as empty value", func(t *testing.T) {
+		// given:
+		value := optional.Of(1)
+
+		// when:
+		err := value.Scan(nil)
+
+		// then:
+		require.NoError(t, err)
+		assert.True(t, value.IsEmpty())
+	})
+
+	scanTests := map[string]struct {
+		src      any
+		scan     func(src any) (any, error)
+		expected any
+	}{
+		"int64 into int": {
+			src:      int64(42),
+			scan:     scanInto[int],
+			expected: 42,
+		},
+		"bytes into string": {
+			src:      []byte("abc"),
+			scan:     scanInto[string],
+			expected: "abc",
+		},
+		"string into int": {
+			src:      "42",
+			scan:     scanInto[int],
+			expected: 42,
+		},
+		"time into time": {
+			src:      time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			scan:     scanInto[time.Time],
+			expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		"int64 into bool": {
+			src:      int64(1),
+			scan:     scanInto[bool],
+			expected: true,
+		},
+	}
+	for name, test := range scanTests {
+		t.Run("scan "+name, func(t *testing.T) {
+			// when:
+			result, err := test.scan(test.src)
+
+			// then:
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	t.Run("scan incompatible value returns error", func(t *testing.T) {
+		// when:
+		var value optional.Value[int]
+		err := value.Scan("abc")
+
+		// then:
+		require.Error(t, err)
+	})
+
+	valueTests := map[string]struct {
+		value    driver.Valuer
+		expected driver.Value
+	}{
+		"empty is NULL": {
+			value:    optional.Empty[int](),
+			expected: nil,
+		},
+		"int is converted to int64": {
+			value:    optional.Of(42),
+			expected: int64(42),
+		},
+		"string": {
+			value:    optional.Of("abc"),
+			expected: "abc",
+		},
+		"custom string type is converted to string": {
+			value:    optional.Of(customString("abc")),
+			expected: "abc",
+		},
+		"driver.Valuer value uses its Value method": {
+			value:    optional.Of(valuer{}),
+			expected: "from valuer",
+		},
+	}
+	for name, test := range valueTests {
+		t.Run("value "+name, func(t *testing.T) {
+			// when:
+			result, err := test.value.Value()
+
+			// then:
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+type customString string
+
+type valuer struct{}
+
+func (valuer) Value() (driver.Value, error) {
+	return "from valuer", nil
+}
+
+func scanInto[V any](src any) (any, error) {
+	var value optional.Value[V]
+	err := value.Scan(src)
+	return value.OrZeroValue(), err
+}
+
+func ptrTo[V any](v V) *V {
+	return &v
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-softwarelab/common/pkg/optional"
 	"github.com/go-softwarelab/common/pkg/seq"
 	"github.com/go-softwarelab/common/pkg/seqerr"
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 func ExampleEmpty() {
@@ -67,6 +68,26 @@ func ExampleOfValue() {
 	// Zero value present: false
 }
 
+func ExampleOfOK() {
+	lazy := types.NewLazy(func() string {
+		return "computed"
+	})
+
+	// Before the first access
+	opt := optional.OfOK(lazy.Peek())
+	fmt.Println("Computed:", opt.IsPresent())
+
+	lazy.Get()
+
+	// After the first access
+	opt = optional.OfOK(lazy.Peek())
+	fmt.Println("Value:", opt.MustGet())
+
+	// Output:
+	// Computed: false
+	// Value: computed
+}
+
 func ExampleValue_Or() {
 	opt1 := optional.Of("first")
 	opt2 := optional.Of("second")
@@ -301,3 +322,41 @@ func ExampleValue_OrZeroValue() {
 	// Present value: 42
 	// Empty value: 0
 }
+
+func ExampleValue_Filter() {
+	opt := optional.Of(42)
+
+	even := opt.Filter(func(v int) bool { return v%2 == 0 })
+	fmt.Println("Even is present:", even.IsPresent())
+
+	odd := opt.Filter(func(v int) bool { return v%2 == 1 })
+	fmt.Println("Odd is present:", odd.IsPresent())
+
+	// Output:
+	// Even is present: true
+	// Odd is present: false
+}
+
+func ExampleValue_ToPtr() {
+	ptr := optional.Of("hello").ToPtr()
+	fmt.Println("Value:", *ptr)
+
+	nilPtr := optional.Empty[string]().ToPtr()
+	fmt.Println("Is nil:", nilPtr == nil)
+
+	// Output:
+	// Value: hello
+	// Is nil: true
+}
+
+func ExampleValue_ToResult() {
+	result := optional.Of(42).ToResult()
+	fmt.Println("Value:", result.MustGetValue())
+
+	emptyResult := optional.Empty[int]().ToResult()
+	fmt.Println("Error:", emptyResult.GetError())
+
+	// Output:
+	// Value: 42
+	// Error: value is not present
+}
@@ -1,5 +1,11 @@
 package optional
 
+import (
+	"iter"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
 // Map is a function that maps the value of optional if it is present.
 func Map[E, R any](o Value[E], f func(E) R) Value[R] {
 	if o.IsEmpty() {
@@ -8,3 +14,92 @@ func Map[E, R any](o Value[E], f func(E) R) Value[R] {
 
 	return Of(f(*o.value))
 }
+
+// FlatMap is a function that maps the value of optional if it is present to another optional.
+func FlatMap[E, R any](o Value[E], f func(E) Value[R]) Value[R] {
+	if o.IsEmpty() {
+		return Empty[R]()
+	}
+
+	return f(*o.value)
+}
+
+// MapOrErr is a function that maps the value of optional if it is present with a mapper that can return an error.
+// If the optional is empty, the mapper is not called and an empty optional with nil error is returned.
+// If the mapper returns an error, an empty optional and the error are returned.
+func MapOrErr[E, R any](o Value[E], f func(E) (R, error)) (Value[R], error) {
+	if o.IsEmpty() {
+		return Empty[R](), nil
+	}
+
+	result, err := f(*o.value)
+	if err != nil {
+		return Empty[R](), err
+	}
+
+	return Of(result), nil
+}
+
+// Zip combines two optionals into an optional of types.Tuple2.
+// The result is present only if both optionals are present.
+func Zip[A, B any](a Value[A], b Value[B]) Value[types.Tuple2[A, B]] {
+	if a.IsEmpty() || b.IsEmpty() {
+		return Empty[types.Tuple2[A, B]]()
+	}
+
+	return Some(types.NewTuple2(*a.value, *b.value))
+}
+
+// Zip3 combines three optionals into an optional of types.Tuple3.
+// The result is present only if all optionals are present.
+func Zip3[A, B, C any](a Value[A], b Value[B], c Value[C]) Value[types.Tuple3[A, B, C]] {
+	if a.IsEmpty() || b.IsEmpty() || c.IsEmpty() {
+		return Empty[types.Tuple3[A, B, C]]()
+	}
+
+	return Some(types.NewTuple3(*a.value, *b.value, *c.value))
+}
+
+// First returns the first present optional from the given ones, or an empty optional if none is present.
+func First[V any](values ...Value[V]) Value[V] {
+	for _, v := range values {
+		if v.IsPresent() {
+			return v
+		}
+	}
+
+	return Empty[V]()
+}
+
+// Collect collects the values of present optionals from the sequence into a slice, empty optionals are dropped.
+func Collect[V any](seq iter.Seq[Value[V]]) []V {
+	var result []V
+	for v := range seq {
+		if v.IsPresent() {
+			result = append(result, *v.value)
+		}
+	}
+
+	return result
+}
+
+// FromResult returns an optional with the value of the result, or an empty optional if the result is an error.
+func FromResult[V any](result *types.Result[V]) Value[V] {
+	if result == nil || result.IsError() {
+		return Empty[V]()
+	}
+
+	return Some(result.MustGetValue())
+}
+
+// PeekLazy returns an optional with the value of the lazy value if it has been already computed, otherwise an empty optional.
+// It never triggers the computation. It works with types.Lazy, types.LazyErr and types.ResettableLazy.
+func PeekLazy[V any](lazy interface{ Peek() (V, bool) }) Value[V] {
+	return OfOK(lazy.Peek())
+}
+
+// NonEmptyFrom returns an optional with types.NonEmpty holding a copy of the given slice,
+// or an empty optional if the slice is empty.
+func NonEmptyFrom[T any](slice []T) Value[types.NonEmpty[T]] {
+	return OfOK(types.NonEmptyFrom(slice))
+}
@@ -1,10 +1,14 @@
 package optional_test
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-softwarelab/common/pkg/optional"
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 func ExampleMap() {
@@ -63,3 +67,128 @@ func ExampleMap_chain() {
 	// Result type: []uint8
 	// Result length: 10
 }
+
+func ExampleFlatMap() {
+	parse := func(s string) optional.Value[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return optional.Empty[int]()
+		}
+		return optional.Of(n)
+	}
+
+	parsed := optional.FlatMap(optional.Of("42"), parse)
+	fmt.Println("Parsed value:", parsed.MustGet())
+
+	invalid := optional.FlatMap(optional.Of("abc"), parse)
+	fmt.Println("Invalid is empty:", invalid.IsEmpty())
+
+	// Output:
+	// Parsed value: 42
+	// Invalid is empty: true
+}
+
+func ExampleMapOrErr() {
+	parsed, err := optional.MapOrErr(optional.Of("42"), strconv.Atoi)
+	fmt.Println("Parsed value:", parsed.MustGet(), err)
+
+	_, err = optional.MapOrErr(optional.Of("abc"), strconv.Atoi)
+	fmt.Println("Error occurred:", err != nil)
+
+	empty, err := optional.MapOrErr(optional.Empty[string](), strconv.Atoi)
+	fmt.Println("Empty is empty:", empty.IsEmpty(), err)
+
+	// Output:
+	// Parsed value: 42 <nil>
+	// Error occurred: true
+	// Empty is empty: true <nil>
+}
+
+func ExampleZip() {
+	zipped := optional.Zip(optional.Of("answer"), optional.Of(42))
+	fmt.Println("Zipped:", zipped.MustGet())
+
+	missing := optional.Zip(optional.Of("answer"), optional.Empty[int]())
+	fmt.Println("Missing is empty:", missing.IsEmpty())
+
+	// Output:
+	// Zipped: {answer 42}
+	// Missing is empty: true
+}
+
+func ExampleZip3() {
+	zipped := optional.Zip3(optional.Of("a"), optional.Of(1), optional.Of(true))
+
+	tuple := zipped.MustGet()
+	fmt.Println(tuple.A, tuple.B, tuple.C)
+
+	// Output:
+	// a 1 true
+}
+
+func ExampleFirst() {
+	fromEnv := optional.Empty[string]()
+	fromConfig := optional.Of("config")
+	fallback := optional.Of("default")
+
+	fmt.Println(optional.First(fromEnv, fromConfig, fallback).MustGet())
+
+	// Output:
+	// config
+}
+
+func ExampleCollect() {
+	values := seq.Of(optional.Of(1), optional.Empty[int](), optional.Of(3))
+
+	fmt.Println(optional.Collect(values))
+
+	// Output:
+	// [1 3]
+}
+
+func ExampleFromResult() {
+	success := optional.FromResult(types.SuccessResult(42))
+	fmt.Println("Success value:", success.MustGet())
+
+	failure := optional.FromResult(types.FailureResult[int](errors.New("failed")))
+	fmt.Println("Failure is empty:", failure.IsEmpty())
+
+	// Output:
+	// Success value: 42
+	// Failure is empty: true
+}
+
+func ExamplePeekLazy() {
+	lazy := types.NewLazy(func() string {
+		return "computed"
+	})
+	fmt.Println("Before access:", optional.PeekLazy(lazy).IsPresent())
+
+	lazy.Get()
+	fmt.Println("After access:", optional.PeekLazy(lazy).MustGet())
+
+	failing := types.NewLazyErr(func() (string, error) {
+		return "", errors.New("failed")
+	})
+	_, err := failing.Get()
+	fmt.Println("Error:", err)
+	fmt.Println("After failure:", optional.PeekLazy(failing).IsPresent())
+
+	// Output:
+	// Before access: false
+	// After access: computed
+	// Error: failed
+	// After failure: false
+}
+
+func ExampleNonEmptyFrom() {
+	scores := optional.NonEmptyFrom([]int{3, 1, 2})
+	fmt.Println("Max score:", optional.Map(scores, types.NonEmptyMax).OrElse(0))
+
+	noScores := optional.NonEmptyFrom([]int{})
+	fmt.Println("Max score:", optional.Map(noScores, types.NonEmptyMax).OrElse(0))
+
+	// Output:
+	// Max score: 3
+	// Max score: 0
+}
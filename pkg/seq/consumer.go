@@ -3,6 +3,8 @@ package seq
 import (
 	"iter"
 	"slices"
+
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 // Consumer is a function that consumes an element of a sequence.
@@ -51,6 +53,23 @@ func Collect[E any](seq iter.Seq[E]) []E {
 	return slices.Collect(seq)
 }
 
+// ToSet collects the elements of the given sequence into a set.
+// If the given set is nil, a new set is created.
+func ToSet[E comparable](seq iter.Seq[E], set types.Set[E]) types.Set[E] {
+	if set == nil {
+		set = make(types.Set[E])
+	}
+	for v := range seq {
+		set.Add(v)
+	}
+	return set
+}
+
+// CollectToSet collects the elements of the given sequence into a new set.
+func CollectToSet[E comparable](seq iter.Seq[E]) types.Set[E] {
+	return types.SetFrom(seq)
+}
+
 // Count returns the number of elements in the sequence.
 func Count[E any](seq iter.Seq[E]) int {
 	i := 0
@@ -59,3 +78,36 @@ func Count[E any](seq iter.Seq[E]) int {
 	}
 	return i
 }
+
+// Unzip3 collects a sequence of types.Tuple3 into 3 slices, one for each element of the tuple.
+func Unzip3[A any, B any, C any](seq iter.Seq[types.Tuple3[A, B, C]]) (as []A, bs []B, cs []C) {
+	for t := range seq {
+		as = append(as, t.A)
+		bs = append(bs, t.B)
+		cs = append(cs, t.C)
+	}
+	return as, bs, cs
+}
+
+// Unzip4 collects a sequence of types.Tuple4 into 4 slices, one for each element of the tuple.
+func Unzip4[A any, B any, C any, D any](seq iter.Seq[types.Tuple4[A, B, C, D]]) (as []A, bs []B, cs []C, ds []D) {
+	for t := range seq {
+		as = append(as, t.A)
+		bs = append(bs, t.B)
+		cs = append(cs, t.C)
+		ds = append(ds, t.D)
+	}
+	return as, bs, cs, ds
+}
+
+// Unzip5 collects a sequence of types.Tuple5 into 5 slices, one for each element of the tuple.
+func Unzip5[A any, B any, C any, D any, E any](seq iter.Seq[types.Tuple5[A, B, C, D, E]]) (as []A, bs []B, cs []C, ds []D, es []E) {
+	for t := range seq {
+		as = append(as, t.A)
+		bs = append(bs, t.B)
+		cs = append(cs, t.C)
+		ds = append(ds, t.D)
+		es = append(es, t.E)
+	}
+	return as, bs, cs, ds, es
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 func ExampleTap() {
@@ -52,3 +53,42 @@ func ExampleCollect() {
 	// Output:
 	// [1 2 3]
 }
+
+func ExampleToSet() {
+	set := types.NewSet(1)
+
+	set = seq.ToSet(seq.Of(2, 3, 2), set)
+
+	fmt.Println(types.SortedSetValues(set))
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleCollectToSet() {
+	sequence := seq.Of("a", "b", "a")
+
+	set := seq.CollectToSet(sequence)
+
+	fmt.Println(set.Len())
+	fmt.Println(set.Contains("a"))
+	// Output:
+	// 2
+	// true
+}
+
+func ExampleUnzip3() {
+	tuples := seq.Of(
+		types.NewTuple3("Alice", 30, true),
+		types.NewTuple3("Bob", 25, false),
+	)
+
+	names, ages, active := seq.Unzip3(tuples)
+
+	fmt.Println(names)
+	fmt.Println(ages)
+	fmt.Println(active)
+	// Output:
+	// [Alice Bob]
+	// [30 25]
+	// [true false]
+}
@@ -1,6 +1,10 @@
 package seq
 
-import "iter"
+import (
+	"iter"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
 
 // Predicate is a function that takes an element and returns a boolean.
 type Predicate[E any] = Mapper[E, bool]
@@ -132,10 +136,10 @@ func Limit[E any](seq iter.Seq[E], n int) iter.Seq[E] {
 // Uniq returns a sequence with only unique elements.
 func Uniq[E comparable](seq iter.Seq[E]) iter.Seq[E] {
 	return func(yield func(E) bool) {
-		seen := make(map[E]struct{})
+		seen := make(types.Set[E])
 		for v := range seq {
-			if _, ok := seen[v]; !ok {
-				seen[v] = struct{}{}
+			if !seen.Contains(v) {
+				seen.Add(v)
 				if !yield(v) {
 					break
 				}
@@ -153,11 +157,11 @@ func Distinct[E comparable](seq iter.Seq[E]) iter.Seq[E] {
 // UniqBy returns a sequence with only unique elements based on a key.
 func UniqBy[E any, K comparable](seq iter.Seq[E], mapper Mapper[E, K]) iter.Seq[E] {
 	return func(yield func(E) bool) {
-		seen := make(map[K]struct{})
+		seen := make(types.Set[K])
 		for v := range seq {
 			key := mapper(v)
-			if _, ok := seen[key]; !ok {
-				seen[key] = struct{}{}
+			if !seen.Contains(key) {
+				seen.Add(key)
 				if !yield(v) {
 					break
 				}
@@ -3,6 +3,8 @@ package seq
 import (
 	"iter"
 	"slices"
+
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 // Partition splits the sequence into chunks of the given size.
@@ -70,3 +72,19 @@ func GroupBy[E any, K comparable](seq iter.Seq[E], by Mapper[E, K]) iter.Seq2[K,
 		}
 	}
 }
+
+// PartitionEither splits the sequence of types.Either into two slices: one with left values and one with right values.
+// The order of the values in the slices is the same as in the sequence.
+func PartitionEither[L, R any](seq iter.Seq[types.Either[L, R]]) ([]L, []R) {
+	var lefts []L
+	var rights []R
+	for v := range seq {
+		if right, isRight := v.GetRight(); isRight {
+			rights = append(rights, right)
+		} else {
+			left, _ := v.GetLeft()
+			lefts = append(lefts, left)
+		}
+	}
+	return lefts, rights
+}
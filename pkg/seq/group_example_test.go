@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-softwarelab/common/pkg/seq"
 	"github.com/go-softwarelab/common/pkg/seq2"
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 func ExamplePartition() {
@@ -73,3 +74,20 @@ func ExampleGroupBy() {
 	// 0: [2 4 6]
 	// 1: [1 3 5]
 }
+
+func ExamplePartitionEither() {
+	input := seq.Of(
+		types.Left[string, int]("cached"),
+		types.Right[string](1),
+		types.Left[string, int]("stale"),
+		types.Right[string](2),
+	)
+
+	lefts, rights := seq.PartitionEither(input)
+
+	fmt.Println(lefts)
+	fmt.Println(rights)
+	// Output:
+	// [cached stale]
+	// [1 2]
+}
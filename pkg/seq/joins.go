@@ -63,3 +63,87 @@ func Zip[E any, R any](seq1 iter.Seq[E], seq2 iter.Seq[R]) iter.Seq2[E, R] {
 		}
 	}
 }
+
+// Zip3 combines 3 sequences into a sequence of types.Tuple3.
+// The resulting sequence ends when all input sequences are exhausted, missing elements are zero values.
+func Zip3[A any, B any, C any](seq1 iter.Seq[A], seq2 iter.Seq[B], seq3 iter.Seq[C]) iter.Seq[types.Tuple3[A, B, C]] {
+	return func(yield func(types.Tuple3[A, B, C]) bool) {
+		next1, stop1 := iter.Pull(seq1)
+		defer stop1()
+		next2, stop2 := iter.Pull(seq2)
+		defer stop2()
+		next3, stop3 := iter.Pull(seq3)
+		defer stop3()
+
+		for {
+			v1, exist1 := next1()
+			v2, exist2 := next2()
+			v3, exist3 := next3()
+			if !exist1 && !exist2 && !exist3 {
+				break
+			}
+			if !yield(types.NewTuple3(v1, v2, v3)) {
+				break
+			}
+		}
+	}
+}
+
+// Zip4 combines 4 sequences into a sequence of types.Tuple4.
+// The resulting sequence ends when all input sequences are exhausted, missing elements are zero values.
+func Zip4[A any, B any, C any, D any](seq1 iter.Seq[A], seq2 iter.Seq[B], seq3 iter.Seq[C], seq4 iter.Seq[D]) iter.Seq[types.Tuple4[A, B, C, D]] {
+	return func(yield func(types.Tuple4[A, B, C, D]) bool) {
+		next1, stop1 := iter.Pull(seq1)
+		defer stop1()
+		next2, stop2 := iter.Pull(seq2)
+		defer stop2()
+		next3, stop3 := iter.Pull(seq3)
+		defer stop3()
+		next4, stop4 := iter.Pull(seq4)
+		defer stop4()
+
+		for {
+			v1, exist1 := next1()
+			v2, exist2 := next2()
+			v3, exist3 := next3()
+			v4, exist4 := next4()
+			if !exist1 && !exist2 && !exist3 && !exist4 {
+				break
+			}
+			if !yield(types.NewTuple4(v1, v2, v3, v4)) {
+				break
+			}
+		}
+	}
+}
+
+// Zip5 combines 5 sequences into a sequence of types.Tuple5.
+// The resulting sequence ends when all input sequences are exhausted, missing elements are zero values.
+func Zip5[A any, B any, C any, D any, E any](seq1 iter.Seq[A], seq2 iter.Seq[B], seq3 iter.Seq[C], seq4 iter.Seq[D], seq5 iter.Seq[E]) iter.Seq[types.Tuple5[A, B, C, D, E]] {
+	return func(yield func(types.Tuple5[A, B, C, D, E]) bool) {
+		next1, stop1 := iter.Pull(seq1)
+		defer stop1()
+		next2, stop2 := iter.Pull(seq2)
+		defer stop2()
+		next3, stop3 := iter.Pull(seq3)
+		defer stop3()
+		next4, stop4 := iter.Pull(seq4)
+		defer stop4()
+		next5, stop5 := iter.Pull(seq5)
+		defer stop5()
+
+		for {
+			v1, exist1 := next1()
+			v2, exist2 := next2()
+			v3, exist3 := next3()
+			v4, exist4 := next4()
+			v5, exist5 := next5()
+			if !exist1 && !exist2 && !exist3 && !exist4 && !exist5 {
+				break
+			}
+			if !yield(types.NewTuple5(v1, v2, v3, v4, v5)) {
+				break
+			}
+		}
+	}
+}
@@ -83,3 +83,18 @@ func ExampleZip() {
 	// 2: b
 	// 3: c
 }
+
+func ExampleZip3() {
+	names := seq.Of("Alice", "Bob")
+	ages := seq.Of(30, 25)
+	active := seq.Of(true, false)
+
+	zipped := seq.Zip3(names, ages, active)
+
+	for t := range zipped {
+		fmt.Println(t.A, t.B, t.C)
+	}
+	// Output:
+	// Alice 30 true
+	// Bob 25 false
+}
@@ -54,6 +54,24 @@ func CollectToMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
 	return maps.Collect(seq)
 }
 
+// ToOrderedMap collects the elements of the given sequence into an ordered map, preserving the order of the sequence.
+// If the given map is nil, a new map is created.
+func ToOrderedMap[K comparable, V any](seq iter.Seq2[K, V], m *types.OrderedMap[K, V]) *types.OrderedMap[K, V] {
+	if m == nil {
+		m = types.NewOrderedMap[K, V]()
+	}
+	for k, v := range seq {
+		m.Set(k, v)
+	}
+	return m
+}
+
+// CollectToOrderedMap collects the elements of the given sequence into a new ordered map, preserving the order of the sequence.
+// If the key occurs multiple times, the last value wins, but the key keeps the position of its first occurrence.
+func CollectToOrderedMap[K comparable, V any](seq iter.Seq2[K, V]) *types.OrderedMap[K, V] {
+	return types.OrderedMapFrom(seq)
+}
+
 // Collect collects the elements of the given sequence into a slice of types.Pair of K and V.
 func Collect[K comparable, V any](seq iter.Seq2[K, V]) []types.Pair[K, V] {
 	pairs := MapTo(seq, func(k K, v V) types.Pair[K, V] {
@@ -70,3 +88,8 @@ func Count[K any, V any](seq iter.Seq2[K, V]) int {
 	}
 	return i
 }
+
+// CollectTuples collects the elements of the given sequence into a slice of types.Tuple2 of K and V.
+func CollectTuples[K any, V any](seq iter.Seq2[K, V]) []types.Tuple2[K, V] {
+	return slices.Collect(ToTuples(seq))
+}
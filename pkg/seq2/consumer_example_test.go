@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"iter"
 
+	"github.com/go-softwarelab/common/pkg/seq"
 	"github.com/go-softwarelab/common/pkg/seq2"
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 func ExampleTap() {
@@ -104,6 +106,37 @@ func ExampleCollectToMap() {
 	// map[a:1 b:2 c:3]
 }
 
+func ExampleToOrderedMap() {
+	m := types.NewOrderedMap[string, int]()
+	m.Set("z", 0)
+
+	input := seq.MapTo(seq.Of("b", "a"), func(s string) (string, int) {
+		return s, len(s)
+	})
+
+	seq2.ToOrderedMap(input, m)
+
+	fmt.Println(seq.Collect(m.Keys()))
+	// Output:
+	// [z b a]
+}
+
+func ExampleCollectToOrderedMap() {
+	input := seq.MapTo(seq.Of("c", "a", "b"), func(s string) (string, int) {
+		return s, len(s)
+	})
+
+	result := seq2.CollectToOrderedMap(input)
+
+	for k, v := range result.All() {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// c 1
+	// a 1
+	// b 1
+}
+
 func ExampleCollect() {
 	input := seq2.FromMap(map[string]int{"a": 1, "b": 2, "c": 3})
 	input = seq2.SortByKeys(input)
@@ -127,3 +160,14 @@ func ExampleCount() {
 	// Output:
 	// 3
 }
+
+func ExampleCollectTuples() {
+	input := seq2.FromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	input = seq2.SortByKeys(input)
+
+	result := seq2.CollectTuples(input)
+
+	fmt.Println(result)
+	// Output:
+	// [{a 1} {b 2} {c 3}]
+}
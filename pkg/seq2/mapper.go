@@ -1,6 +1,10 @@
 package seq2
 
-import "iter"
+import (
+	"iter"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
 
 // Mapper is a function that takes an element and returns a new element.
 type Mapper[K, V, R any] = func(K, V) R
@@ -77,3 +81,15 @@ func CycleTimes[K, V any](seq iter.Seq2[K, V], count int) iter.Seq2[K, V] {
 		}
 	}
 }
+
+// ToTuples converts the sequence into a sequence of types.Tuple2 of K and V.
+func ToTuples[K, V any](seq iter.Seq2[K, V]) iter.Seq[types.Tuple2[K, V]] {
+	return MapTo(seq, types.NewTuple2[K, V])
+}
+
+// ToPairs converts the sequence into a sequence of types.Pair of K and V.
+func ToPairs[K, V any](seq iter.Seq2[K, V]) iter.Seq[types.Pair[K, V]] {
+	return MapTo(seq, func(k K, v V) types.Pair[K, V] {
+		return types.Pair[K, V]{Left: k, Right: v}
+	})
+}
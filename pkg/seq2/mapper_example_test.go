@@ -110,3 +110,23 @@ func ExampleCycle() {
 	// b 2
 	// a 1
 }
+
+func ExampleToTuples() {
+	input := seq2.FromSlice([]string{"a", "b"})
+
+	tuples := seq2.ToTuples(input)
+
+	fmt.Println(seq.Collect(tuples))
+	// Output:
+	// [{0 a} {1 b}]
+}
+
+func ExampleToPairs() {
+	input := seq2.FromSlice([]string{"a", "b"})
+
+	pairs := seq2.ToPairs(input)
+
+	fmt.Println(seq.Collect(pairs))
+	// Output:
+	// [{0 a} {1 b}]
+}
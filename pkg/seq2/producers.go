@@ -133,3 +133,25 @@ func Reverse[K, V any](seq iter.Seq2[K, V]) iter.Seq2[K, V] {
 		}
 	}
 }
+
+// FromTuples creates a new iter.Seq2 from the sequence of types.Tuple2, the first element of the tuple becomes the key.
+func FromTuples[K, V any](tuples iter.Seq[types.Tuple2[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for t := range tuples {
+			if !yield(t.A, t.B) {
+				return
+			}
+		}
+	}
+}
+
+// FromPairs creates a new iter.Seq2 from the sequence of types.Pair, the left element of the pair becomes the key.
+func FromPairs[K, V any](pairs iter.Seq[types.Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := range pairs {
+			if !yield(p.Left, p.Right) {
+				return
+			}
+		}
+	}
+}
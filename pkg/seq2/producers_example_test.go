@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-softwarelab/common/pkg/seq"
 	"github.com/go-softwarelab/common/pkg/seq2"
+	"github.com/go-softwarelab/common/pkg/types"
 )
 
 func ExampleEmpty() {
@@ -126,3 +127,25 @@ func ExampleTick() {
 	// tick 4 at 00:00:00.003
 	// tick 5 at 00:00:00.004
 }
+
+func ExampleFromTuples() {
+	tuples := seq.Of(types.NewTuple2("a", 1), types.NewTuple2("b", 2))
+
+	for k, v := range seq2.FromTuples(tuples) {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// a 1
+	// b 2
+}
+
+func ExampleFromPairs() {
+	pairs := seq.Of(*types.NewPair("a", 1), *types.NewPair("b", 2))
+
+	for k, v := range seq2.FromPairs(pairs) {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// a 1
+	// b 2
+}
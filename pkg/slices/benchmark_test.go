@@ -0,0 +1,155 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/seq2"
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+const benchmarkSize = 10_000
+
+func benchmarkInput() []int {
+	input := make([]int, benchmarkSize)
+	for i := range input {
+		input[i] = (i * 7919) % benchmarkSize
+	}
+	return input
+}
+
+func BenchmarkPartition(b *testing.B) {
+	input := benchmarkInput()
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.Partition(input, 100)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq.Collect(seq.Map(seq.Partition(seq.FromSlice(input), 100), seq.Collect[int]))
+		}
+	})
+}
+
+func BenchmarkGroupBy(b *testing.B) {
+	input := benchmarkInput()
+	byMod := func(v int) int { return v % 10 }
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.GroupBy(input, byMod)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq2.CollectToMap(seq2.MapValues(seq.GroupBy(seq.FromSlice(input), byMod), seq.Collect[int]))
+		}
+	})
+}
+
+func BenchmarkFindLast(b *testing.B) {
+	input := benchmarkInput()
+	isZero := func(v int) bool { return v == 0 }
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.FindLast(input, isZero)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq.FindLast(seq.FromSlice(input), isZero)
+		}
+	})
+}
+
+func BenchmarkSortBy(b *testing.B) {
+	input := benchmarkInput()
+	negate := func(v int) int { return -v }
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.SortBy(input, negate)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq.Collect(seq.SortBy(seq.FromSlice(input), negate))
+		}
+	})
+}
+
+func BenchmarkZip(b *testing.B) {
+	input := benchmarkInput()
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.Zip(input, input)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq2.Collect(seq.Zip(seq.FromSlice(input), seq.FromSlice(input)))
+		}
+	})
+}
+
+func BenchmarkReverse(b *testing.B) {
+	input := benchmarkInput()
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.Reverse(input)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq.Collect(seq.Reverse(seq.FromSlice(input)))
+		}
+	})
+}
+
+func BenchmarkIntersect(b *testing.B) {
+	input := benchmarkInput()
+	other := input[:benchmarkSize/2]
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.Intersect(input, other)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq.Collect(seq.Uniq(seq.Filter(seq.FromSlice(input), func(v int) bool {
+				return seq.Contains(seq.FromSlice(other), v)
+			})))
+		}
+	})
+}
+
+func BenchmarkWindow(b *testing.B) {
+	input := benchmarkInput()
+
+	b.Run("slices", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.Window(input, 10)
+		}
+	})
+
+	b.Run("seq", func(b *testing.B) {
+		for b.Loop() {
+			_ = seq.Collect(seq.Map(seq.Range(0, benchmarkSize-10+1), func(i int) []int {
+				return seq.Collect(seq.Take(seq.Skip(seq.FromSlice(input), i), 10))
+			}))
+		}
+	})
+}
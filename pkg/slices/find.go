@@ -0,0 +1,26 @@
+package slices
+
+import "github.com/go-softwarelab/common/pkg/optional"
+
+// Find returns the first element that satisfies the predicate.
+func Find[E any](collection []E, predicate func(E) bool) optional.Value[E] {
+	for _, e := range collection {
+		if predicate(e) {
+			return optional.Of(e)
+		}
+	}
+
+	return optional.Empty[E]()
+}
+
+// FindLast returns the last element that satisfies the predicate.
+// Unlike the sequence version, it iterates from the end of the collection and stops on the first match.
+func FindLast[E any](collection []E, predicate func(E) bool) optional.Value[E] {
+	for i := len(collection) - 1; i >= 0; i-- {
+		if predicate(collection[i]) {
+			return optional.Of(collection[i])
+		}
+	}
+
+	return optional.Empty[E]()
+}
@@ -0,0 +1,31 @@
+package slices_test
+
+import (
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+func ExampleFind() {
+	collection := []int{1, 2, 3, 4, 5}
+
+	found := slices.Find(collection, func(v int) bool { return v > 3 })
+	fmt.Println(found.MustGet())
+
+	notFound := slices.Find(collection, func(v int) bool { return v > 5 })
+	fmt.Println(notFound.IsEmpty())
+
+	// Output:
+	// 4
+	// true
+}
+
+func ExampleFindLast() {
+	collection := []int{1, 2, 3, 4, 5}
+
+	found := slices.FindLast(collection, func(v int) bool { return v > 3 })
+	fmt.Println(found.MustGet())
+
+	// Output:
+	// 5
+}
@@ -0,0 +1,93 @@
+package slices
+
+// Partition splits the collection into chunks of the given size.
+// The last chunk may be shorter than size.
+// Chunks are sub-slices of the original collection (no elements are copied), each capped to its length,
+// so appending to a chunk will not overwrite elements of the next one.
+func Partition[E any, Slice ~[]E](collection Slice, size int) []Slice {
+	if size <= 0 {
+		panic("size must be greater than 0")
+	}
+
+	result := make([]Slice, 0, (len(collection)+size-1)/size)
+	for i := 0; i < len(collection); i += size {
+		end := min(i+size, len(collection))
+		result = append(result, collection[i:end:end])
+	}
+
+	return result
+}
+
+// Chunk splits the collection into chunks of the given size.
+// Alias for Partition.
+func Chunk[E any, Slice ~[]E](collection Slice, size int) []Slice {
+	return Partition(collection, size)
+}
+
+// PartitionBy splits the collection into chunks based on the given key.
+// It splits the collection whenever the key changes, the order matters here.
+// Chunks are sub-slices of the original collection (no elements are copied), each capped to its length.
+func PartitionBy[E any, K comparable, Slice ~[]E](collection Slice, by func(E) K) []Slice {
+	if len(collection) == 0 {
+		return []Slice{}
+	}
+
+	var result []Slice
+	start := 0
+	lastKey := by(collection[0])
+	for i := 1; i < len(collection); i++ {
+		key := by(collection[i])
+		if key != lastKey {
+			result = append(result, collection[start:i:i])
+			start = i
+			lastKey = key
+		}
+	}
+
+	return append(result, collection[start:len(collection):len(collection)])
+}
+
+// Window returns all sliding windows of the given size over the collection, moving one element at a time.
+// If the collection is shorter than size, the result is empty.
+// Windows are sub-slices of the original collection (no elements are copied), each capped to its length.
+func Window[E any, Slice ~[]E](collection Slice, size int) []Slice {
+	if size <= 0 {
+		panic("size must be greater than 0")
+	}
+
+	if len(collection) < size {
+		return []Slice{}
+	}
+
+	result := make([]Slice, len(collection)-size+1)
+	for i := range result {
+		result[i] = collection[i : i+size : i+size]
+	}
+
+	return result
+}
+
+// GroupBy groups the elements of the collection by the given key.
+// The order of elements within each group is the same as in the original collection.
+func GroupBy[E any, K comparable, Slice ~[]E](collection Slice, by func(E) K) map[K]Slice {
+	result := make(map[K]Slice)
+
+	for _, e := range collection {
+		key := by(e)
+		result[key] = append(result[key], e)
+	}
+
+	return result
+}
+
+// KeyBy returns a map of elements of the collection indexed by the given key.
+// If multiple elements have the same key, the last one wins.
+func KeyBy[E any, K comparable](collection []E, by func(E) K) map[K]E {
+	result := make(map[K]E, len(collection))
+
+	for _, e := range collection {
+		result[by(e)] = e
+	}
+
+	return result
+}
@@ -0,0 +1,99 @@
+package slices_test
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+func ExamplePartition() {
+	collection := []int{1, 2, 3, 4, 5}
+
+	partitions := slices.Partition(collection, 2)
+	fmt.Println(partitions)
+
+	// Output:
+	// [[1 2] [3 4] [5]]
+}
+
+func ExampleChunk() {
+	collection := []int{1, 2, 3, 4, 5, 6}
+
+	chunks := slices.Chunk(collection, 3)
+	fmt.Println(chunks)
+
+	// Output:
+	// [[1 2 3] [4 5 6]]
+}
+
+func ExamplePartitionBy() {
+	collection := []int{1, 2, 3, 4, 1, 5, 6}
+
+	partitions := slices.PartitionBy(collection, func(v int) int {
+		return (v - 1) / 3
+	})
+	fmt.Println(partitions)
+
+	// Output:
+	// [[1 2 3] [4] [1] [5 6]]
+}
+
+func ExampleWindow() {
+	collection := []int{1, 2, 3, 4, 5}
+
+	windows := slices.Window(collection, 3)
+	fmt.Println(windows)
+
+	// Output:
+	// [[1 2 3] [2 3 4] [3 4 5]]
+}
+
+func ExampleGroupBy() {
+	collection := []int{1, 2, 3, 4, 5, 6}
+
+	groups := slices.GroupBy(collection, func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	fmt.Println(groups["even"])
+	fmt.Println(groups["odd"])
+
+	// Output:
+	// [2 4 6]
+	// [1 3 5]
+}
+
+func ExampleKeyBy() {
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	users := []User{
+		{ID: 1, Name: "Alice"},
+		{ID: 2, Name: "Bob"},
+	}
+
+	byID := slices.KeyBy(users, func(u User) int {
+		return u.ID
+	})
+
+	ids := make([]int, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	// KeyBy returns a map, so we sort the keys for display
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		fmt.Printf("%d: %s\n", id, byID[id].Name)
+	}
+
+	// Output:
+	// 1: Alice
+	// 2: Bob
+}
@@ -0,0 +1,59 @@
+package slices
+
+import "github.com/go-softwarelab/common/pkg/types"
+
+// Zip combines two collections into a slice of types.Pair.
+// The result has the length of the longer collection, missing elements of the shorter one are zero values.
+func Zip[E any, R any](collection1 []E, collection2 []R) []types.Pair[E, R] {
+	result := make([]types.Pair[E, R], max(len(collection1), len(collection2)))
+
+	for i := range collection1 {
+		result[i].Left = collection1[i]
+	}
+	for i := range collection2 {
+		result[i].Right = collection2[i]
+	}
+
+	return result
+}
+
+// Intersect returns the distinct elements of the collection that are also present in the other collection.
+// The order of result values is determined by the order they occur in the collection.
+func Intersect[E comparable, Slice ~[]E](collection Slice, other Slice) Slice {
+	inOther := make(map[E]struct{}, len(other))
+	for _, e := range other {
+		inOther[e] = struct{}{}
+	}
+
+	result := make(Slice, 0, min(len(collection), len(inOther)))
+	for _, e := range collection {
+		if _, ok := inOther[e]; ok {
+			result = append(result, e)
+			// ensures every element is added only once
+			delete(inOther, e)
+		}
+	}
+
+	return result
+}
+
+// Difference returns the distinct elements of the collection that are not present in the other collection.
+// The order of result values is determined by the order they occur in the collection.
+func Difference[E comparable, Slice ~[]E](collection Slice, other Slice) Slice {
+	seen := make(map[E]struct{}, len(collection)+len(other))
+	for _, e := range other {
+		seen[e] = struct{}{}
+	}
+
+	result := make(Slice, 0, len(collection))
+	for _, e := range collection {
+		if _, ok := seen[e]; ok {
+			continue
+		}
+
+		seen[e] = struct{}{}
+		result = append(result, e)
+	}
+
+	return result
+}
@@ -0,0 +1,44 @@
+package slices_test
+
+import (
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+func ExampleZip() {
+	numbers := []int{1, 2, 3}
+	letters := []string{"a", "b"}
+
+	zipped := slices.Zip(numbers, letters)
+	for _, pair := range zipped {
+		fmt.Printf("%d: %q\n", pair.Left, pair.Right)
+	}
+
+	// Output:
+	// 1: "a"
+	// 2: "b"
+	// 3: ""
+}
+
+func ExampleIntersect() {
+	collection := []int{1, 2, 2, 3, 4}
+	other := []int{2, 4, 6}
+
+	common := slices.Intersect(collection, other)
+	fmt.Println(common)
+
+	// Output:
+	// [2 4]
+}
+
+func ExampleDifference() {
+	collection := []int{1, 2, 3, 3, 4}
+	other := []int{2, 4, 6}
+
+	diff := slices.Difference(collection, other)
+	fmt.Println(diff)
+
+	// Output:
+	// [1 3]
+}
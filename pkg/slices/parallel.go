@@ -0,0 +1,219 @@
+package slices
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+// chunksPerWorker is the number of chunks created for each worker by default,
+// it allows to balance the work between workers when processing time of elements differs.
+const chunksPerWorker = 4
+
+// ParallelOptions is a set of options for parallel operations on slices.
+type ParallelOptions struct {
+	parallelism int
+	chunkSize   int
+}
+
+// WithParallelism sets the maximum number of goroutines processing the collection.
+// By default, it is equal to runtime.GOMAXPROCS(0).
+// Values lower than 1 are ignored.
+func WithParallelism(parallelism int) func(*ParallelOptions) {
+	return func(options *ParallelOptions) {
+		if parallelism > 0 {
+			options.parallelism = parallelism
+		}
+	}
+}
+
+// WithChunkSize sets the number of consecutive elements processed by a worker at once.
+// By default, the collection is split into several chunks per worker.
+// Values lower than 1 are ignored.
+func WithChunkSize(chunkSize int) func(*ParallelOptions) {
+	return func(options *ParallelOptions) {
+		if chunkSize > 0 {
+			options.chunkSize = chunkSize
+		}
+	}
+}
+
+// ParallelMap returns new slice where each element is a result of applying mapper to each element of the original slice.
+// The mapper is called concurrently, so it must be safe for concurrent use. The order of elements is preserved.
+// If the mapper panics, the panic is propagated to the caller after all workers have finished.
+func ParallelMap[E any, R any](collection []E, mapper Mapper[E, R], opts ...func(*ParallelOptions)) []R {
+	result := make([]R, len(collection))
+
+	_ = runInParallel(context.Background(), len(collection), opts, func(_ context.Context, start, end int) error {
+		for i := start; i < end; i++ {
+			result[i] = mapper(collection[i])
+		}
+		return nil
+	})
+
+	return result
+}
+
+// ParallelMapOrError returns new slice where each element is a result of applying mapper to each element of the original slice.
+// The mapper is called concurrently, so it must be safe for concurrent use. The order of elements is preserved.
+// If any of the mappers return an error, the context passed to the mappers is canceled,
+// no further elements are processed, and the first error is returned.
+// If the mapper panics, the panic is propagated to the caller after all workers have finished.
+func ParallelMapOrError[E any, R any](ctx context.Context, collection []E, mapper func(context.Context, E) (R, error), opts ...func(*ParallelOptions)) ([]R, error) {
+	result := make([]R, len(collection))
+
+	err := runInParallel(ctx, len(collection), opts, func(ctx context.Context, start, end int) error {
+		for i := start; i < end; i++ {
+			if ctx.Err() != nil {
+				return ctx.Err() //nolint:wrapcheck
+			}
+
+			mapped, err := mapper(ctx, collection[i])
+			if err != nil {
+				return err
+			}
+			result[i] = mapped
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ParallelForEach applies consumer to each element of the collection.
+// The consumer is called concurrently, so it must be safe for concurrent use, and the order of calls is not guaranteed.
+// If the consumer panics, the panic is propagated to the caller after all workers have finished.
+func ParallelForEach[E any](collection []E, consumer Consumer[E], opts ...func(*ParallelOptions)) {
+	_ = runInParallel(context.Background(), len(collection), opts, func(_ context.Context, start, end int) error {
+		for i := start; i < end; i++ {
+			consumer(collection[i])
+		}
+		return nil
+	})
+}
+
+// ParallelReduce reduces the collection to a single value, processing its chunks concurrently.
+// Each chunk is reduced with accumulator (from left to right) starting from the initial value,
+// then results of the chunks are combined (in the order of chunks) with combiner.
+// Therefore, the initial value must be an identity for combiner (like 0 for sum),
+// and combiner must be associative.
+// If the accumulator or combiner panics, the panic is propagated to the caller.
+func ParallelReduce[E any, R any](collection []E, accumulator func(agg R, item E) R, combiner func(left R, right R) R, initial R, opts ...func(*ParallelOptions)) R {
+	options := parallelOptions(len(collection), opts)
+	chunkResults := make([]R, chunksCount(len(collection), options.chunkSize))
+
+	_ = runInParallel(context.Background(), len(collection), opts, func(_ context.Context, start, end int) error {
+		agg := initial
+		for i := start; i < end; i++ {
+			agg = accumulator(agg, collection[i])
+		}
+		chunkResults[start/options.chunkSize] = agg
+		return nil
+	})
+
+	if len(chunkResults) == 0 {
+		return initial
+	}
+
+	result := chunkResults[0]
+	for _, r := range chunkResults[1:] {
+		result = combiner(result, r)
+	}
+
+	return result
+}
+
+func parallelOptions(length int, opts []func(*ParallelOptions)) ParallelOptions {
+	options := to.OptionsWithDefault(ParallelOptions{
+		parallelism: runtime.GOMAXPROCS(0),
+	}, opts...)
+
+	if options.chunkSize == 0 {
+		options.chunkSize = max(1, length/(options.parallelism*chunksPerWorker))
+	}
+
+	return options
+}
+
+func chunksCount(length int, chunkSize int) int {
+	return (length + chunkSize - 1) / chunkSize
+}
+
+// runInParallel splits range [0, length) into chunks and runs work on them by a pool of workers.
+// It returns the first error returned by work and cancels the context passed to the other calls.
+// Panics from work are recovered and the first one is re-panicked in the calling goroutine.
+func runInParallel(ctx context.Context, length int, opts []func(*ParallelOptions), work func(ctx context.Context, start, end int) error) error {
+	if length == 0 {
+		return nil
+	}
+
+	options := parallelOptions(length, opts)
+	chunks := chunksCount(length, options.chunkSize)
+	workers := min(options.parallelism, chunks)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		nextChunk atomic.Int64
+		once      sync.Once
+		firstErr  error
+		recovered any
+		panicked  bool
+	)
+
+	fail := func(err error, panicValue any, isPanic bool) {
+		once.Do(func() {
+			firstErr = err
+			recovered = panicValue
+			panicked = isPanic
+			cancel()
+		})
+	}
+
+	worker := func() {
+		defer wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				fail(nil, r, true)
+			}
+		}()
+
+		for ctx.Err() == nil {
+			chunk := int(nextChunk.Add(1) - 1)
+			if chunk >= chunks {
+				return
+			}
+
+			start := chunk * options.chunkSize
+			end := min(start+options.chunkSize, length)
+			if err := work(ctx, start, end); err != nil {
+				fail(err, nil, false)
+				return
+			}
+		}
+	}
+
+	wg.Add(workers)
+	for range workers {
+		go worker()
+	}
+	wg.Wait()
+
+	if panicked {
+		panic(recovered)
+	}
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err() //nolint:wrapcheck
+}
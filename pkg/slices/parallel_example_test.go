@@ -0,0 +1,91 @@
+package slices_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+func ExampleParallelMap() {
+	collection := []int{1, 2, 3, 4, 5}
+
+	squared := slices.ParallelMap(collection, func(v int) int {
+		return v * v
+	})
+	fmt.Println(squared)
+
+	// Output:
+	// [1 4 9 16 25]
+}
+
+func ExampleParallelMap_withOptions() {
+	collection := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	doubled := slices.ParallelMap(collection, func(v int) int {
+		return v * 2
+	}, slices.WithParallelism(2), slices.WithChunkSize(3))
+	fmt.Println(doubled)
+
+	// Output:
+	// [2 4 6 8 10 12 14 16]
+}
+
+func ExampleParallelMapOrError() {
+	collection := []string{"1", "2", "3"}
+
+	parsed, err := slices.ParallelMapOrError(context.Background(), collection, func(_ context.Context, v string) (int, error) {
+		return strconv.Atoi(v)
+	})
+	fmt.Println(parsed, err)
+
+	// Now with an error case
+	collection = []string{"1", "invalid", "3"}
+	_, err = slices.ParallelMapOrError(context.Background(), collection, func(_ context.Context, v string) (int, error) {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, errors.New("invalid number")
+		}
+		return n, nil
+	})
+	fmt.Println(err)
+
+	// Output:
+	// [1 2 3] <nil>
+	// invalid number
+}
+
+func ExampleParallelForEach() {
+	collection := []int{1, 2, 3, 4, 5}
+
+	var sum atomic.Int64
+	slices.ParallelForEach(collection, func(v int) {
+		sum.Add(int64(v))
+	})
+	fmt.Println(sum.Load())
+
+	// Output:
+	// 15
+}
+
+func ExampleParallelReduce() {
+	collection := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	sum := slices.ParallelReduce(collection,
+		func(agg int, item int) int {
+			return agg + item
+		},
+		func(left int, right int) int {
+			return left + right
+		},
+		0,
+		slices.WithChunkSize(3),
+	)
+	fmt.Println(sum)
+
+	// Output:
+	// 55
+}
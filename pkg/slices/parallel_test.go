@@ -0,0 +1,165 @@
+package slices_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelMap(t *testing.T) {
+	t.Run("preserves order for any chunk size and parallelism", func(t *testing.T) {
+		// given:
+		collection := make([]int, 1000)
+		for i := range collection {
+			collection[i] = i
+		}
+
+		for _, chunkSize := range []int{1, 7, 1000, 5000} {
+			for _, parallelism := range []int{1, 3, 16} {
+				// when:
+				result := slices.ParallelMap(collection, func(v int) int {
+					return v * 2
+				}, slices.WithChunkSize(chunkSize), slices.WithParallelism(parallelism))
+
+				// then:
+				require.Len(t, result, len(collection))
+				for i, v := range result {
+					assert.Equal(t, i*2, v)
+				}
+			}
+		}
+	})
+
+	t.Run("empty collection", func(t *testing.T) {
+		// when:
+		result := slices.ParallelMap([]int{}, func(v int) int { return v })
+
+		// then:
+		assert.Empty(t, result)
+	})
+
+	t.Run("propagates panic to the caller", func(t *testing.T) {
+		// given:
+		collection := []int{1, 2, 3, 4}
+
+		// then:
+		assert.PanicsWithValue(t, "boom", func() {
+			slices.ParallelMap(collection, func(v int) int {
+				if v == 3 {
+					panic("boom")
+				}
+				return v
+			}, slices.WithChunkSize(1))
+		})
+	})
+}
+
+func TestParallelMapOrError(t *testing.T) {
+	t.Run("returns the first error and cancels the rest", func(t *testing.T) {
+		// given:
+		collection := make([]int, 1000)
+		expectedErr := errors.New("failed")
+		var processed atomic.Int64
+
+		// when:
+		result, err := slices.ParallelMapOrError(t.Context(), collection, func(ctx context.Context, v int) (int, error) {
+			if processed.Add(1) == 1 {
+				return 0, expectedErr
+			}
+			<-ctx.Done()
+			return v, nil
+		}, slices.WithParallelism(4), slices.WithChunkSize(1))
+
+		// then:
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, result)
+		assert.Less(t, processed.Load(), int64(len(collection)), "processing should stop after the error")
+	})
+
+	t.Run("returns error when parent context is canceled", func(t *testing.T) {
+		// given:
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		// when:
+		_, err := slices.ParallelMapOrError(ctx, []int{1, 2, 3}, func(_ context.Context, v int) (int, error) {
+			return v, nil
+		})
+
+		// then:
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("propagates panic to the caller", func(t *testing.T) {
+		assert.PanicsWithValue(t, "boom", func() {
+			_, _ = slices.ParallelMapOrError(t.Context(), []int{1, 2, 3}, func(_ context.Context, v int) (int, error) {
+				panic("boom")
+			})
+		})
+	})
+}
+
+func TestParallelForEach(t *testing.T) {
+	t.Run("visits every element exactly once", func(t *testing.T) {
+		// given:
+		collection := make([]int, 1000)
+		visits := make([]atomic.Int32, len(collection))
+		for i := range collection {
+			collection[i] = i
+		}
+
+		// when:
+		slices.ParallelForEach(collection, func(v int) {
+			visits[v].Add(1)
+		}, slices.WithChunkSize(9))
+
+		// then:
+		for i := range visits {
+			assert.Equal(t, int32(1), visits[i].Load(), "element %d", i)
+		}
+	})
+
+	t.Run("propagates panic to the caller", func(t *testing.T) {
+		assert.PanicsWithValue(t, "boom", func() {
+			slices.ParallelForEach([]int{1}, func(int) {
+				panic("boom")
+			})
+		})
+	})
+}
+
+func TestParallelReduce(t *testing.T) {
+	t.Run("combines chunk results in order", func(t *testing.T) {
+		// given:
+		collection := strings.Split("abcdefghijklmnopqrstuvwxyz", "")
+
+		// when:
+		result := slices.ParallelReduce(collection,
+			func(agg string, item string) string { return agg + item },
+			func(left string, right string) string { return left + right },
+			"",
+			slices.WithChunkSize(4), slices.WithParallelism(3),
+		)
+
+		// then:
+		assert.Equal(t, "abcdefghijklmnopqrstuvwxyz", result)
+	})
+
+	t.Run("returns initial value for empty collection", func(t *testing.T) {
+		// when:
+		result := slices.ParallelReduce([]int{},
+			func(agg int, item int) int { return agg + item },
+			func(left int, right int) int { return left + right },
+			42,
+		)
+
+		// then:
+		assert.Equal(t, 42, result)
+	})
+}
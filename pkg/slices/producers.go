@@ -0,0 +1,13 @@
+package slices
+
+// Reverse returns a new collection with the elements in reverse order.
+// The original collection is not modified.
+func Reverse[E any, Slice ~[]E](collection Slice) Slice {
+	result := make(Slice, len(collection))
+
+	for i, e := range collection {
+		result[len(collection)-1-i] = e
+	}
+
+	return result
+}
@@ -0,0 +1,19 @@
+package slices_test
+
+import (
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+func ExampleReverse() {
+	collection := []int{1, 2, 3}
+
+	reversed := slices.Reverse(collection)
+	fmt.Println(reversed)
+	fmt.Println(collection)
+
+	// Output:
+	// [3 2 1]
+	// [1 2 3]
+}
@@ -0,0 +1,49 @@
+package slices
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+// Sort returns a new collection with the elements sorted in ascending order.
+// The original collection is not modified.
+func Sort[E types.Ordered, Slice ~[]E](collection Slice) Slice {
+	result := slices.Clone(collection)
+	slices.Sort(result)
+	return result
+}
+
+// SortBy returns a new collection with the elements sorted in ascending order by the key returned by keyFn.
+// The key is computed only once per element. The original collection is not modified.
+func SortBy[E any, K types.Ordered, Slice ~[]E](collection Slice, keyFn func(E) K) Slice {
+	type pair struct {
+		k K
+		e E
+	}
+
+	withKey := make([]pair, len(collection))
+	for i, e := range collection {
+		withKey[i] = pair{keyFn(e), e}
+	}
+
+	slices.SortFunc(withKey, func(a, b pair) int {
+		return cmp.Compare(a.k, b.k)
+	})
+
+	result := make(Slice, len(collection))
+	for i := range withKey {
+		result[i] = withKey[i].e
+	}
+
+	return result
+}
+
+// SortComparing returns a new collection with the elements sorted in ascending order using the cmp function.
+// The original collection is not modified.
+func SortComparing[E any, Slice ~[]E](collection Slice, cmp func(a, b E) int) Slice {
+	result := slices.Clone(collection)
+	slices.SortFunc(result, cmp)
+	return result
+}
@@ -0,0 +1,44 @@
+package slices_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+func ExampleSort() {
+	collection := []int{3, 1, 2}
+
+	sorted := slices.Sort(collection)
+	fmt.Println(sorted)
+	fmt.Println(collection)
+
+	// Output:
+	// [1 2 3]
+	// [3 1 2]
+}
+
+func ExampleSortBy() {
+	collection := []string{"banana", "kiwi", "apple"}
+
+	sorted := slices.SortBy(collection, func(s string) int {
+		return len(s)
+	})
+	fmt.Println(sorted)
+
+	// Output:
+	// [kiwi apple banana]
+}
+
+func ExampleSortComparing() {
+	collection := []string{"b", "C", "a"}
+
+	sorted := slices.SortComparing(collection, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	fmt.Println(sorted)
+
+	// Output:
+	// [a b C]
+}
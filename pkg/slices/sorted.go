@@ -0,0 +1,233 @@
+package slices
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+// All functions in this file expect the collection to be sorted in ascending order
+// (by natural order, by the key or by the cmp function respectively), the result is undefined otherwise.
+
+// LowerBound returns the index of the first element in the sorted collection that is not less than value.
+// If all elements are less than value, it returns len(collection).
+func LowerBound[E types.Ordered](collection []E, value E) int {
+	return lowerBound(collection, compareTo(value))
+}
+
+// LowerBoundBy returns the index of the first element in the sorted collection whose key is not less than key.
+// If all keys are less than key, it returns len(collection).
+func LowerBoundBy[E any, K types.Ordered](collection []E, key K, keyFn func(E) K) int {
+	return lowerBound(collection, compareKeyTo(key, keyFn))
+}
+
+// LowerBoundComparing returns the index of the first element in the sorted collection that is not less than value according to cmp.
+// If all elements are less than value, it returns len(collection).
+func LowerBoundComparing[E any](collection []E, value E, cmp func(a, b E) int) int {
+	return lowerBound(collection, compareWith(value, cmp))
+}
+
+// UpperBound returns the index of the first element in the sorted collection that is greater than value.
+// If no element is greater than value, it returns len(collection).
+func UpperBound[E types.Ordered](collection []E, value E) int {
+	return upperBound(collection, compareTo(value))
+}
+
+// UpperBoundBy returns the index of the first element in the sorted collection whose key is greater than key.
+// If no key is greater than key, it returns len(collection).
+func UpperBoundBy[E any, K types.Ordered](collection []E, key K, keyFn func(E) K) int {
+	return upperBound(collection, compareKeyTo(key, keyFn))
+}
+
+// UpperBoundComparing returns the index of the first element in the sorted collection that is greater than value according to cmp.
+// If no element is greater than value, it returns len(collection).
+func UpperBoundComparing[E any](collection []E, value E, cmp func(a, b E) int) int {
+	return upperBound(collection, compareWith(value, cmp))
+}
+
+// EqualRange returns the range [start, end) of indexes of elements in the sorted collection that are equal to value.
+// If there is no such element, start equals end and points to the place where value would be inserted.
+func EqualRange[E types.Ordered](collection []E, value E) (start int, end int) {
+	return equalRange(collection, compareTo(value))
+}
+
+// EqualRangeBy returns the range [start, end) of indexes of elements in the sorted collection whose key is equal to key.
+// If there is no such element, start equals end and points to the place where element with such key would be inserted.
+func EqualRangeBy[E any, K types.Ordered](collection []E, key K, keyFn func(E) K) (start int, end int) {
+	return equalRange(collection, compareKeyTo(key, keyFn))
+}
+
+// EqualRangeComparing returns the range [start, end) of indexes of elements in the sorted collection that are equal to value according to cmp.
+// If there is no such element, start equals end and points to the place where value would be inserted.
+func EqualRangeComparing[E any](collection []E, value E, cmp func(a, b E) int) (start int, end int) {
+	return equalRange(collection, compareWith(value, cmp))
+}
+
+// InsertSorted inserts value into the sorted collection keeping it sorted, after any elements equal to value.
+// Like append, it may modify the underlying array of the collection, so always use the returned slice.
+func InsertSorted[E types.Ordered, Slice ~[]E](collection Slice, value E) Slice {
+	return slices.Insert(collection, UpperBound(collection, value), value)
+}
+
+// InsertSortedBy inserts value into the collection sorted by key keeping it sorted, after any elements with equal key.
+// Like append, it may modify the underlying array of the collection, so always use the returned slice.
+func InsertSortedBy[E any, K types.Ordered, Slice ~[]E](collection Slice, value E, keyFn func(E) K) Slice {
+	return slices.Insert(collection, UpperBoundBy(collection, keyFn(value), keyFn), value)
+}
+
+// InsertSortedComparing inserts value into the collection sorted with cmp keeping it sorted, after any elements equal to value.
+// Like append, it may modify the underlying array of the collection, so always use the returned slice.
+func InsertSortedComparing[E any, Slice ~[]E](collection Slice, value E, cmp func(a, b E) int) Slice {
+	return slices.Insert(collection, UpperBoundComparing(collection, value, cmp), value)
+}
+
+// RemoveSorted removes all elements equal to value from the sorted collection.
+// It modifies the collection in place (like slices.Delete), so always use the returned slice.
+func RemoveSorted[E types.Ordered, Slice ~[]E](collection Slice, value E) Slice {
+	start, end := EqualRange(collection, value)
+	return slices.Delete(collection, start, end)
+}
+
+// RemoveSortedBy removes all elements with key equal to key from the collection sorted by key.
+// It modifies the collection in place (like slices.Delete), so always use the returned slice.
+func RemoveSortedBy[E any, K types.Ordered, Slice ~[]E](collection Slice, key K, keyFn func(E) K) Slice {
+	start, end := EqualRangeBy(collection, key, keyFn)
+	return slices.Delete(collection, start, end)
+}
+
+// RemoveSortedComparing removes all elements equal to value according to cmp from the collection sorted with cmp.
+// It modifies the collection in place (like slices.Delete), so always use the returned slice.
+func RemoveSortedComparing[E any, Slice ~[]E](collection Slice, value E, cmp func(a, b E) int) Slice {
+	start, end := EqualRangeComparing(collection, value, cmp)
+	return slices.Delete(collection, start, end)
+}
+
+// MergeSorted merges two sorted collections into a new sorted collection.
+// Elements of the first collection are placed before equal elements of the second one.
+func MergeSorted[E types.Ordered, Slice ~[]E](collection Slice, other Slice) Slice {
+	return MergeSortedComparing(collection, other, cmp.Compare[E])
+}
+
+// MergeSortedBy merges two collections sorted by key into a new collection sorted by key.
+// Elements of the first collection are placed before elements of the second one with equal key.
+func MergeSortedBy[E any, K types.Ordered, Slice ~[]E](collection Slice, other Slice, keyFn func(E) K) Slice {
+	return MergeSortedComparing(collection, other, func(a, b E) int {
+		return cmp.Compare(keyFn(a), keyFn(b))
+	})
+}
+
+// MergeSortedComparing merges two collections sorted with cmp into a new collection sorted with cmp.
+// Elements of the first collection are placed before equal elements of the second one.
+func MergeSortedComparing[E any, Slice ~[]E](collection Slice, other Slice, cmp func(a, b E) int) Slice {
+	result := make(Slice, 0, len(collection)+len(other))
+
+	i, j := 0, 0
+	for i < len(collection) && j < len(other) {
+		if cmp(other[j], collection[i]) < 0 {
+			result = append(result, other[j])
+			j++
+		} else {
+			result = append(result, collection[i])
+			i++
+		}
+	}
+
+	result = append(result, collection[i:]...)
+	return append(result, other[j:]...)
+}
+
+// UniqSorted returns a new collection with only unique elements of the sorted collection.
+// It is more efficient than Uniq, as it only compares neighbouring elements.
+func UniqSorted[E types.Ordered, Slice ~[]E](collection Slice) Slice {
+	return UniqSortedComparing(collection, cmp.Compare[E])
+}
+
+// UniqSortedBy returns a new collection with only elements with unique key of the collection sorted by key.
+// Only the first element with each key is kept.
+func UniqSortedBy[E any, K types.Ordered, Slice ~[]E](collection Slice, keyFn func(E) K) Slice {
+	result := make(Slice, 0, len(collection))
+
+	var lastKey K
+	for i, e := range collection {
+		key := keyFn(e)
+		if i == 0 || key != lastKey {
+			result = append(result, e)
+			lastKey = key
+		}
+	}
+
+	return result
+}
+
+// UniqSortedComparing returns a new collection with only unique elements (according to cmp) of the collection sorted with cmp.
+// Only the first of equal elements is kept.
+func UniqSortedComparing[E any, Slice ~[]E](collection Slice, cmp func(a, b E) int) Slice {
+	result := make(Slice, 0, len(collection))
+
+	for i, e := range collection {
+		if i == 0 || cmp(result[len(result)-1], e) != 0 {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
+
+// IsSorted returns true if the collection is sorted in ascending order.
+func IsSorted[E types.Ordered](collection []E) bool {
+	return slices.IsSorted(collection)
+}
+
+// IsSortedBy returns true if the collection is sorted in ascending order by the key returned by keyFn.
+func IsSortedBy[E any, K types.Ordered](collection []E, keyFn func(E) K) bool {
+	for i := 1; i < len(collection); i++ {
+		if keyFn(collection[i]) < keyFn(collection[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSortedComparing returns true if the collection is sorted in ascending order according to cmp.
+func IsSortedComparing[E any](collection []E, cmp func(a, b E) int) bool {
+	return slices.IsSortedFunc(collection, cmp)
+}
+
+func compareTo[E types.Ordered](value E) func(E) int {
+	return func(e E) int {
+		return cmp.Compare(e, value)
+	}
+}
+
+func compareKeyTo[E any, K types.Ordered](key K, keyFn func(E) K) func(E) int {
+	return func(e E) int {
+		return cmp.Compare(keyFn(e), key)
+	}
+}
+
+func compareWith[E any](value E, cmp func(a, b E) int) func(E) int {
+	return func(e E) int {
+		return cmp(e, value)
+	}
+}
+
+func lowerBound[E any](collection []E, compareToTarget func(E) int) int {
+	return sort.Search(len(collection), func(i int) bool {
+		return compareToTarget(collection[i]) >= 0
+	})
+}
+
+func upperBound[E any](collection []E, compareToTarget func(E) int) int {
+	return sort.Search(len(collection), func(i int) bool {
+		return compareToTarget(collection[i]) > 0
+	})
+}
+
+func equalRange[E any](collection []E, compareToTarget func(E) int) (int, int) {
+	start := lowerBound(collection, compareToTarget)
+	end := start + upperBound(collection[start:], compareToTarget)
+	return start, end
+}
@@ -0,0 +1,234 @@
+package slices_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-softwarelab/common/pkg/slices"
+)
+
+type event struct {
+	ID        int
+	Timestamp int64
+}
+
+func ExampleLowerBound() {
+	collection := []int{1, 3, 3, 5}
+
+	fmt.Println(slices.LowerBound(collection, 3))
+	fmt.Println(slices.LowerBound(collection, 4))
+	fmt.Println(slices.LowerBound(collection, 6))
+
+	// Output:
+	// 1
+	// 3
+	// 4
+}
+
+func ExampleLowerBoundBy() {
+	events := []event{{ID: 1, Timestamp: 100}, {ID: 2, Timestamp: 200}, {ID: 3, Timestamp: 300}}
+
+	idx := slices.LowerBoundBy(events, 150, func(e event) int64 {
+		return e.Timestamp
+	})
+	fmt.Println(events[idx:])
+
+	// Output:
+	// [{2 200} {3 300}]
+}
+
+func ExampleLowerBoundComparing() {
+	collection := []string{"a", "B", "c"}
+
+	idx := slices.LowerBoundComparing(collection, "b", func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	fmt.Println(idx)
+
+	// Output:
+	// 1
+}
+
+func ExampleUpperBound() {
+	collection := []int{1, 3, 3, 5}
+
+	fmt.Println(slices.UpperBound(collection, 3))
+	fmt.Println(slices.UpperBound(collection, 0))
+
+	// Output:
+	// 3
+	// 0
+}
+
+func ExampleUpperBoundBy() {
+	events := []event{{ID: 1, Timestamp: 100}, {ID: 2, Timestamp: 200}, {ID: 3, Timestamp: 300}}
+
+	idx := slices.UpperBoundBy(events, 200, func(e event) int64 {
+		return e.Timestamp
+	})
+	fmt.Println(events[:idx])
+
+	// Output:
+	// [{1 100} {2 200}]
+}
+
+func ExampleEqualRange() {
+	collection := []int{1, 3, 3, 5}
+
+	start, end := slices.EqualRange(collection, 3)
+	fmt.Println(start, end)
+
+	start, end = slices.EqualRange(collection, 4)
+	fmt.Println(start, end)
+
+	// Output:
+	// 1 3
+	// 3 3
+}
+
+func ExampleEqualRangeBy() {
+	events := []event{{ID: 1, Timestamp: 100}, {ID: 2, Timestamp: 200}, {ID: 3, Timestamp: 200}}
+
+	start, end := slices.EqualRangeBy(events, 200, func(e event) int64 {
+		return e.Timestamp
+	})
+	fmt.Println(events[start:end])
+
+	// Output:
+	// [{2 200} {3 200}]
+}
+
+func ExampleInsertSorted() {
+	collection := []int{1, 3, 5}
+
+	collection = slices.InsertSorted(collection, 4)
+	collection = slices.InsertSorted(collection, 0)
+	fmt.Println(collection)
+
+	// Output:
+	// [0 1 3 4 5]
+}
+
+func ExampleInsertSortedBy() {
+	events := []event{{ID: 1, Timestamp: 100}, {ID: 3, Timestamp: 300}}
+
+	events = slices.InsertSortedBy(events, event{ID: 2, Timestamp: 200}, func(e event) int64 {
+		return e.Timestamp
+	})
+	fmt.Println(events)
+
+	// Output:
+	// [{1 100} {2 200} {3 300}]
+}
+
+func ExampleInsertSortedComparing() {
+	collection := []string{"a", "C"}
+
+	collection = slices.InsertSortedComparing(collection, "B", func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	fmt.Println(collection)
+
+	// Output:
+	// [a B C]
+}
+
+func ExampleRemoveSorted() {
+	collection := []int{1, 3, 3, 5}
+
+	collection = slices.RemoveSorted(collection, 3)
+	fmt.Println(collection)
+
+	// Output:
+	// [1 5]
+}
+
+func ExampleRemoveSortedBy() {
+	events := []event{{ID: 1, Timestamp: 100}, {ID: 2, Timestamp: 200}, {ID: 3, Timestamp: 300}}
+
+	events = slices.RemoveSortedBy(events, 200, func(e event) int64 {
+		return e.Timestamp
+	})
+	fmt.Println(events)
+
+	// Output:
+	// [{1 100} {3 300}]
+}
+
+func ExampleMergeSorted() {
+	first := []int{1, 4, 7}
+	second := []int{2, 3, 8, 9}
+
+	merged := slices.MergeSorted(first, second)
+	fmt.Println(merged)
+
+	// Output:
+	// [1 2 3 4 7 8 9]
+}
+
+func ExampleMergeSortedBy() {
+	first := []event{{ID: 1, Timestamp: 100}, {ID: 3, Timestamp: 300}}
+	second := []event{{ID: 2, Timestamp: 200}}
+
+	merged := slices.MergeSortedBy(first, second, func(e event) int64 {
+		return e.Timestamp
+	})
+	fmt.Println(merged)
+
+	// Output:
+	// [{1 100} {2 200} {3 300}]
+}
+
+func ExampleUniqSorted() {
+	collection := []int{1, 1, 2, 3, 3, 3}
+
+	unique := slices.UniqSorted(collection)
+	fmt.Println(unique)
+
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleUniqSortedBy() {
+	events := []event{{ID: 1, Timestamp: 100}, {ID: 2, Timestamp: 100}, {ID: 3, Timestamp: 200}}
+
+	unique := slices.UniqSortedBy(events, func(e event) int64 {
+		return e.Timestamp
+	})
+	fmt.Println(unique)
+
+	// Output:
+	// [{1 100} {3 200}]
+}
+
+func ExampleIsSorted() {
+	fmt.Println(slices.IsSorted([]int{1, 2, 2, 3}))
+	fmt.Println(slices.IsSorted([]int{3, 1}))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleIsSortedBy() {
+	events := []event{{ID: 2, Timestamp: 100}, {ID: 1, Timestamp: 200}}
+
+	fmt.Println(slices.IsSortedBy(events, func(e event) int64 { return e.Timestamp }))
+	fmt.Println(slices.IsSortedBy(events, func(e event) int { return e.ID }))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleIsSortedComparing() {
+	collection := []string{"a", "B", "c"}
+
+	sorted := slices.IsSortedComparing(collection, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	fmt.Println(sorted)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,341 @@
+package slogx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+var _ slog.Handler = (*AsyncHandler)(nil)
+
+// ErrAsyncHandlerClosed is returned when the record is handled after the AsyncHandler was closed.
+var ErrAsyncHandlerClosed = errors.New("async log handler is closed")
+
+// OverflowPolicy describes what AsyncHandler does with a new record, when its buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock blocks the logging goroutine until there is a room in the buffer.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest drops the oldest record from the buffer to make room for the new one.
+	OverflowDropOldest
+	// OverflowDropNewest drops the new record.
+	OverflowDropNewest
+)
+
+// String returns the name of the policy.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowBlock:
+		return "block"
+	case OverflowDropOldest:
+		return "drop-oldest"
+	case OverflowDropNewest:
+		return "drop-newest"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+	}
+}
+
+// AsyncOptions is a set of options for the AsyncHandler.
+type AsyncOptions struct {
+	bufferSize int
+	policy     OverflowPolicy
+	onError    func(error)
+}
+
+// WithAsyncBufferSize sets the number of records which can wait for being handled, by default it's 1024.
+func WithAsyncBufferSize(size int) func(*AsyncOptions) {
+	return func(options *AsyncOptions) {
+		options.bufferSize = size
+	}
+}
+
+// WithOverflowPolicy sets what to do with a new record when the buffer is full, by default it's OverflowBlock.
+func WithOverflowPolicy(policy OverflowPolicy) func(*AsyncOptions) {
+	return func(options *AsyncOptions) {
+		options.policy = policy
+	}
+}
+
+// WithAsyncErrorHandler sets the function called with errors returned by the wrapped handler.
+// As the records are handled in the background, such errors cannot be returned to the logging code.
+// Panics of the wrapped handler are recovered and reported as errors as well.
+func WithAsyncErrorHandler(onError func(error)) func(*AsyncOptions) {
+	return func(options *AsyncOptions) {
+		options.onError = onError
+	}
+}
+
+// AsyncStats contains the counters of the AsyncHandler.
+type AsyncStats struct {
+	// Enqueued is the number of records accepted to the buffer.
+	Enqueued uint64
+	// Handled is the number of records passed to the wrapped handler.
+	Handled uint64
+	// Dropped is the number of records dropped because of the full buffer or the closed handler.
+	Dropped uint64
+	// Errors is the number of records for which the wrapped handler returned an error.
+	Errors uint64
+	// Queued is the number of records waiting in the buffer.
+	Queued int
+}
+
+// AsyncHandler is a slog.Handler that queues records in a bounded buffer and passes them to the wrapped handler
+// in a background goroutine, so logging doesn't block on slow outputs.
+//
+// Use Flush to wait until the already logged records are handled,
+// and Close for a graceful shutdown, after which the records are no longer accepted.
+// The handlers derived with WithAttrs and WithGroup share the buffer and the background goroutine.
+type AsyncHandler struct {
+	next slog.Handler
+	core *asyncCore
+}
+
+type asyncCore struct {
+	policy  OverflowPolicy
+	onError func(error)
+
+	// closing is closed first by Close, so the senders blocked on the full queue give up and release mu
+	closing   chan struct{}
+	closeOnce sync.Once
+
+	// mu protects closing of the queue, it's held for reading while sending to the queue
+	mu      sync.RWMutex
+	closed  bool
+	queue   chan asyncEntry
+	stopped chan struct{}
+
+	// markersMu protects markers taken from the queue by dropOldest, they are closed by run when it receives the next entry
+	markersMu sync.Mutex
+	markers   []chan struct{}
+
+	enqueued atomic.Uint64
+	handled  atomic.Uint64
+	dropped  atomic.Uint64
+	errors   atomic.Uint64
+}
+
+type asyncEntry struct {
+	ctx     context.Context //nolint:containedctx
+	handler slog.Handler
+	record  slog.Record
+	// flushed is set for the marker entries used by Flush, it's closed when the marker is reached
+	flushed chan struct{}
+}
+
+// NewAsyncHandler creates a new AsyncHandler wrapping the given handler and starts its background goroutine.
+func NewAsyncHandler(next slog.Handler, opts ...func(*AsyncOptions)) *AsyncHandler {
+	options := to.OptionsWithDefault(AsyncOptions{
+		bufferSize: 1024,
+		policy:     OverflowBlock,
+	}, opts...)
+
+	core := &asyncCore{
+		policy:  options.policy,
+		onError: options.onError,
+		closing: make(chan struct{}),
+		queue:   make(chan asyncEntry, max(options.bufferSize, 1)),
+		stopped: make(chan struct{}),
+	}
+	go core.run()
+
+	return &AsyncHandler{next: next, core: core}
+}
+
+// Enabled returns true if the wrapped handler is enabled for the level.
+func (h *AsyncHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle queues the record to be handled in the background.
+// It returns ErrAsyncHandlerClosed if the handler has been closed.
+func (h *AsyncHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.core.enqueue(asyncEntry{
+		// the record is handled after the logging call returns, so it mustn't be affected by the cancellation of its context
+		ctx:     context.WithoutCancel(ctx),
+		handler: h.next,
+		record:  record.Clone(),
+	})
+}
+
+// WithAttrs returns a new handler with the given attributes, sharing the buffer with this handler.
+func (h *AsyncHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &AsyncHandler{next: h.next.WithAttrs(attrs), core: h.core}
+}
+
+// WithGroup returns a new handler with the given group, sharing the buffer with this handler.
+func (h *AsyncHandler) WithGroup(name string) slog.Handler {
+	return &AsyncHandler{next: h.next.WithGroup(name), core: h.core}
+}
+
+// Flush waits until all the records logged before the call are handled, or until the context is done.
+func (h *AsyncHandler) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+
+	h.core.mu.RLock()
+	if h.core.closed {
+		h.core.mu.RUnlock()
+		return h.waitForStop(ctx)
+	}
+	select {
+	case h.core.queue <- asyncEntry{flushed: flushed}:
+		h.core.mu.RUnlock()
+	case <-h.core.closing:
+		h.core.mu.RUnlock()
+		return h.waitForStop(ctx)
+	case <-ctx.Done():
+		h.core.mu.RUnlock()
+		return fmt.Errorf("failed to flush logs: %w", ctx.Err())
+	}
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to flush logs: %w", ctx.Err())
+	}
+}
+
+// Close stops accepting new records and waits until all the queued records are handled, or until the context is done.
+// If the context is done first, the queued records are still handled in the background.
+// The records blocked by OverflowBlock policy are dropped, so Close doesn't hang when the wrapped handler never returns.
+// It's safe to call Close multiple times.
+func (h *AsyncHandler) Close(ctx context.Context) error {
+	h.core.closeOnce.Do(func() {
+		close(h.core.closing)
+
+		// the blocked senders return after closing is closed, so the lock can't be held by them for long
+		h.core.mu.Lock()
+		h.core.closed = true
+		close(h.core.queue)
+		h.core.mu.Unlock()
+	})
+
+	return h.waitForStop(ctx)
+}
+
+// Stats returns the current values of the handler counters.
+func (h *AsyncHandler) Stats() AsyncStats {
+	return AsyncStats{
+		Enqueued: h.core.enqueued.Load(),
+		Handled:  h.core.handled.Load(),
+		Dropped:  h.core.dropped.Load(),
+		Errors:   h.core.errors.Load(),
+		Queued:   len(h.core.queue),
+	}
+}
+
+func (h *AsyncHandler) waitForStop(ctx context.Context) error {
+	select {
+	case <-h.core.stopped:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for handling the queued logs: %w", ctx.Err())
+	}
+}
+
+func (c *asyncCore) enqueue(entry asyncEntry) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		c.dropped.Add(1)
+		return ErrAsyncHandlerClosed
+	}
+
+	switch c.policy {
+	case OverflowDropNewest:
+		select {
+		case c.queue <- entry:
+		default:
+			c.dropped.Add(1)
+			return nil
+		}
+	case OverflowDropOldest:
+		for sent := false; !sent; {
+			select {
+			case c.queue <- entry:
+				sent = true
+			default:
+				c.dropOldest()
+			}
+		}
+	default:
+		select {
+		case c.queue <- entry:
+		case <-c.closing:
+			c.dropped.Add(1)
+			return ErrAsyncHandlerClosed
+		}
+	}
+
+	c.enqueued.Add(1)
+	return nil
+}
+
+func (c *asyncCore) dropOldest() {
+	select {
+	case oldest := <-c.queue:
+		if oldest.flushed != nil {
+			// the records before the marker have been already taken from the queue, but they may be still handled,
+			// so the marker is passed to run instead of being closed here
+			c.markersMu.Lock()
+			c.markers = append(c.markers, oldest.flushed)
+			c.markersMu.Unlock()
+			return
+		}
+		c.dropped.Add(1)
+	default:
+	}
+}
+
+func (c *asyncCore) run() {
+	defer close(c.stopped)
+	defer c.closeDroppedMarkers()
+
+	for entry := range c.queue {
+		// the previous entries have been handled, so the markers dropped before receiving this entry are reached
+		c.closeDroppedMarkers()
+
+		if entry.flushed != nil {
+			close(entry.flushed)
+			continue
+		}
+
+		err := c.handle(entry)
+		c.handled.Add(1)
+		if err != nil {
+			c.errors.Add(1)
+			if c.onError != nil {
+				c.onError(err)
+			}
+		}
+	}
+}
+
+// handle passes the entry to its handler, turning a panic of the handler into an error, so it doesn't stop the background goroutine.
+func (c *asyncCore) handle(entry asyncEntry) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("async log handler recovered from panic: %v", r)
+		}
+	}()
+
+	return entry.handler.Handle(entry.ctx, entry.record) //nolint:wrapcheck
+}
+
+func (c *asyncCore) closeDroppedMarkers() {
+	c.markersMu.Lock()
+	defer c.markersMu.Unlock()
+
+	for _, flushed := range c.markers {
+		close(flushed)
+	}
+	c.markers = nil
+}
@@ -0,0 +1,42 @@
+package slogx_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleNewAsyncHandler() {
+	handler := slogx.NewAsyncHandler(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+				if attr.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+				return attr
+			},
+		}),
+		slogx.WithAsyncBufferSize(100),
+		slogx.WithOverflowPolicy(slogx.OverflowDropOldest),
+	)
+	logger := slog.New(handler).With("service", "orders")
+
+	logger.Info("order created")
+	logger.Info("order paid")
+
+	// graceful shutdown: wait until all the queued records are written
+	if err := handler.Close(context.Background()); err != nil {
+		fmt.Println("failed to close the log handler:", err)
+	}
+
+	stats := handler.Stats()
+	fmt.Printf("handled: %d, dropped: %d\n", stats.Handled, stats.Dropped)
+
+	// Output:
+	// level=INFO msg="order created" service=orders
+	// level=INFO msg="order paid" service=orders
+	// handled: 2, dropped: 0
+}
@@ -0,0 +1,302 @@
+package slogx_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+// blockingHandler blocks handling records until it's released.
+type blockingHandler struct {
+	slog.Handler
+	release chan struct{}
+}
+
+func (h blockingHandler) Handle(ctx context.Context, record slog.Record) error {
+	<-h.release
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h blockingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return blockingHandler{Handler: h.Handler.WithAttrs(attrs), release: h.release}
+}
+
+func newBlockedAsyncHandler(t *testing.T, policy slogx.OverflowPolicy) (*slogx.AsyncHandler, *slogx.CollectingLogsWriter, chan struct{}) {
+	t.Helper()
+	output := slogx.NewCollectingLogsWriter()
+	release := make(chan struct{})
+	handler := slogx.NewAsyncHandler(
+		blockingHandler{Handler: slog.NewTextHandler(output, nil), release: release},
+		slogx.WithAsyncBufferSize(2),
+		slogx.WithOverflowPolicy(policy),
+	)
+	return handler, output, release
+}
+
+func logMessages(logger *slog.Logger, messages ...string) {
+	for _, msg := range messages {
+		logger.Info(msg)
+	}
+}
+
+func TestAsyncHandlerDropNewest(t *testing.T) {
+	// given:
+	handler, output, release := newBlockedAsyncHandler(t, slogx.OverflowDropNewest)
+	logger := slog.New(handler)
+
+	// when: the first record is taken by the worker, which is blocked, two next fill the buffer
+	logger.Info("first")
+	require.Eventually(t, func() bool { return handler.Stats().Queued == 0 }, time.Second, time.Millisecond)
+	logMessages(logger, "second", "third", "fourth", "fifth")
+	close(release)
+	require.NoError(t, handler.Close(t.Context()))
+
+	// then:
+	logs := output.String()
+	assert.Contains(t, logs, "msg=first")
+	assert.Contains(t, logs, "msg=second")
+	assert.Contains(t, logs, "msg=third")
+	assert.NotContains(t, logs, "msg=fourth")
+	assert.NotContains(t, logs, "msg=fifth")
+	assert.Equal(t, slogx.AsyncStats{Enqueued: 3, Handled: 3, Dropped: 2}, handler.Stats())
+}
+
+func TestAsyncHandlerDropOldest(t *testing.T) {
+	// given:
+	handler, output, release := newBlockedAsyncHandler(t, slogx.OverflowDropOldest)
+	logger := slog.New(handler)
+
+	// when:
+	logger.Info("first")
+	require.Eventually(t, func() bool { return handler.Stats().Queued == 0 }, time.Second, time.Millisecond)
+	logMessages(logger, "second", "third", "fourth", "fifth")
+	close(release)
+	require.NoError(t, handler.Close(t.Context()))
+
+	// then:
+	logs := output.String()
+	assert.Contains(t, logs, "msg=first")
+	assert.NotContains(t, logs, "msg=second")
+	assert.NotContains(t, logs, "msg=third")
+	assert.Contains(t, logs, "msg=fourth")
+	assert.Contains(t, logs, "msg=fifth")
+	assert.Equal(t, slogx.AsyncStats{Enqueued: 5, Handled: 3, Dropped: 2}, handler.Stats())
+}
+
+func TestAsyncHandlerBlock(t *testing.T) {
+	// given:
+	handler, output, release := newBlockedAsyncHandler(t, slogx.OverflowBlock)
+	logger := slog.New(handler)
+
+	logger.Info("first")
+	require.Eventually(t, func() bool { return handler.Stats().Queued == 0 }, time.Second, time.Millisecond)
+	logMessages(logger, "second", "third")
+
+	// when:
+	done := make(chan struct{})
+	go func() {
+		logger.Info("fourth")
+		close(done)
+	}()
+
+	// then:
+	select {
+	case <-done:
+		t.Fatal("logging should block while the buffer is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-done
+	require.NoError(t, handler.Close(t.Context()))
+	assert.Equal(t, 4, strings.Count(output.String(), "level=INFO"))
+	assert.Equal(t, slogx.AsyncStats{Enqueued: 4, Handled: 4}, handler.Stats())
+}
+
+func TestAsyncHandlerFlush(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	handler := slogx.NewAsyncHandler(slog.NewTextHandler(output, nil))
+	logger := slog.New(handler).With("service", "orders").WithGroup("order")
+
+	// when:
+	logger.Info("created", "id", 1)
+	err := handler.Flush(t.Context())
+
+	// then:
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "msg=created service=orders order.id=1")
+	require.NoError(t, handler.Close(t.Context()))
+}
+
+func TestAsyncHandlerFlushTimeout(t *testing.T) {
+	// given:
+	handler, _, release := newBlockedAsyncHandler(t, slogx.OverflowBlock)
+	defer close(release)
+	slog.New(handler).Info("first")
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	// when:
+	err := handler.Flush(ctx)
+
+	// then:
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAsyncHandlerCloseDoesNotHangWhenHandlerNeverReturns(t *testing.T) {
+	// given:
+	handler, _, release := newBlockedAsyncHandler(t, slogx.OverflowBlock)
+	defer close(release)
+	logger := slog.New(handler)
+
+	logger.Info("first")
+	require.Eventually(t, func() bool { return handler.Stats().Queued == 0 }, time.Second, time.Millisecond)
+	logMessages(logger, "second", "third")
+
+	blocked := make(chan error)
+	go func() {
+		blocked <- handler.Handle(t.Context(), slog.NewRecord(time.Now(), slog.LevelInfo, "fourth", 0))
+	}()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	// when:
+	err := handler.Close(ctx)
+
+	// then:
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, <-blocked, slogx.ErrAsyncHandlerClosed)
+	require.ErrorIs(t, handler.Handle(t.Context(), slog.NewRecord(time.Now(), slog.LevelInfo, "late", 0)), slogx.ErrAsyncHandlerClosed)
+	assert.Equal(t, uint64(2), handler.Stats().Dropped)
+}
+
+func TestAsyncHandlerRejectsRecordsAfterClose(t *testing.T) {
+	// given:
+	handler := slogx.NewAsyncHandler(slog.NewTextHandler(slogx.NewCollectingLogsWriter(), nil))
+	require.NoError(t, handler.Close(t.Context()))
+
+	// when:
+	err := handler.Handle(t.Context(), slog.NewRecord(time.Now(), slog.LevelInfo, "late", 0))
+
+	// then:
+	require.ErrorIs(t, err, slogx.ErrAsyncHandlerClosed)
+	assert.Equal(t, uint64(1), handler.Stats().Dropped)
+	require.NoError(t, handler.Close(t.Context()))
+	require.NoError(t, handler.Flush(t.Context()))
+}
+
+func TestAsyncHandlerReportsErrors(t *testing.T) {
+	// given:
+	errHandle := errors.New("disk full")
+	var reported []error
+	handler := slogx.NewAsyncHandler(
+		failingHandler{Handler: slog.NewTextHandler(slogx.NewCollectingLogsWriter(), nil), err: errHandle},
+		slogx.WithAsyncErrorHandler(func(err error) { reported = append(reported, err) }),
+	)
+
+	// when:
+	slog.New(handler).Info("msg")
+	require.NoError(t, handler.Close(t.Context()))
+
+	// then:
+	assert.Equal(t, []error{errHandle}, reported)
+	assert.Equal(t, uint64(1), handler.Stats().Errors)
+}
+
+func TestAsyncHandlerConcurrentLoggingAndClose(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	handler := slogx.NewAsyncHandler(slog.NewTextHandler(output, nil), slogx.WithAsyncBufferSize(8), slogx.WithOverflowPolicy(slogx.OverflowDropOldest))
+	logger := slog.New(handler)
+
+	// when:
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				logger.Info("msg")
+			}
+			_ = handler.Flush(t.Context())
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, handler.Close(t.Context()))
+
+	// then:
+	stats := handler.Stats()
+	assert.Equal(t, uint64(800), stats.Handled+stats.Dropped)
+	assert.Equal(t, int(stats.Handled), strings.Count(output.String(), "level=INFO"))
+}
+
+func TestAsyncHandlerFlushWaitsForRecordsWhenMarkerIsDropped(t *testing.T) {
+	// given:
+	handler, output, release := newBlockedAsyncHandler(t, slogx.OverflowDropOldest)
+	logger := slog.New(handler)
+	logger.Info("first")
+	require.Eventually(t, func() bool { return handler.Stats().Queued == 0 }, time.Second, time.Millisecond)
+
+	flushed := make(chan error)
+	go func() {
+		flushed <- handler.Flush(t.Context())
+	}()
+	require.Eventually(t, func() bool { return handler.Stats().Queued == 1 }, time.Second, time.Millisecond)
+
+	// when: the flush marker is the oldest entry in the full buffer
+	logMessages(logger, "second", "third")
+
+	// then:
+	select {
+	case <-flushed:
+		t.Fatal("flush should wait until the record logged before it is handled")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-flushed)
+	assert.GreaterOrEqual(t, handler.Stats().Handled, uint64(1))
+	require.NoError(t, handler.Close(t.Context()))
+	assert.Contains(t, output.String(), "msg=first")
+}
+
+// panickingHandler panics on handling every record.
+type panickingHandler struct {
+	slog.Handler
+}
+
+func (panickingHandler) Handle(context.Context, slog.Record) error {
+	panic("boom")
+}
+
+func TestAsyncHandlerRecoversFromHandlerPanic(t *testing.T) {
+	// given:
+	var reported []error
+	handler := slogx.NewAsyncHandler(
+		panickingHandler{Handler: slog.NewTextHandler(slogx.NewCollectingLogsWriter(), nil)},
+		slogx.WithAsyncErrorHandler(func(err error) { reported = append(reported, err) }),
+	)
+	logger := slog.New(handler)
+
+	// when:
+	logMessages(logger, "first", "second")
+	require.NoError(t, handler.Flush(t.Context()))
+	require.NoError(t, handler.Close(t.Context()))
+
+	// then:
+	require.Len(t, reported, 2)
+	assert.ErrorContains(t, reported[0], "boom")
+	assert.Equal(t, slogx.AsyncStats{Enqueued: 2, Handled: 2, Errors: 2}, handler.Stats())
+}
@@ -47,6 +47,11 @@ type LoggerBuilderWithHandler interface {
 	// The order of decorators applies from the first to the last.
 	WithHandlerDecorator(decorators ...HandlerDecorator) LoggerBuilderWithHandler
 
+	// WithAdditionalHandler adds handlers receiving the same records as the configured one.
+	// Each of the additional handlers keeps its own level, format and decorators,
+	// while the decorators added with WithHandlerDecorator are applied to all the handlers.
+	WithAdditionalHandler(handlers ...slog.Handler) LoggerBuilderWithHandler
+
 	LoggerFactory
 }
 
@@ -57,10 +62,11 @@ type LoggerFactory interface {
 }
 
 type builder struct {
-	handler    slog.Handler
-	level      *slog.LevelVar
-	writer     io.Writer
-	decorators []HandlerDecorator
+	handler            slog.Handler
+	additionalHandlers []slog.Handler
+	level              *slog.LevelVar
+	writer             io.Writer
+	decorators         []HandlerDecorator
 }
 
 // NewBuilder creates a new Builder for configuring a logger.
@@ -115,6 +121,14 @@ func (c *builder) WithHandlerDecorator(decorators ...HandlerDecorator) LoggerBui
 	return c
 }
 
+// WithAdditionalHandler adds handlers receiving the same records as the configured one.
+// Each of the additional handlers keeps its own level, format and decorators,
+// while the decorators added with WithHandlerDecorator are applied to all the handlers.
+func (c *builder) WithAdditionalHandler(handlers ...slog.Handler) LoggerBuilderWithHandler {
+	c.additionalHandlers = append(c.additionalHandlers, handlers...)
+	return c
+}
+
 // WithTextFormat sets the log format to "text" for the logger and returns a builder with the handler configured.
 func (c *builder) WithTextFormat() LoggerBuilderWithHandler {
 	return c.WithFormat(TextFormat)
@@ -152,6 +166,9 @@ func (c *builder) WithFormat(handlerType LogFormat) LoggerBuilderWithHandler {
 // Logger creates a new logger from the configuration.
 func (c *builder) Logger() *slog.Logger {
 	var handler = c.handler
+	if len(c.additionalHandlers) > 0 {
+		handler = NewMultiHandler(append([]slog.Handler{handler}, c.additionalHandlers...)...)
+	}
 	options := &DecoratorOptions{}
 
 	for _, decorator := range c.decorators {
@@ -0,0 +1,291 @@
+package slogx
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+var (
+	_ HandlerDecorator = (*ContextAttrsDecorator)(nil)
+	_ slog.Handler     = (*contextAttrsHandler)(nil)
+)
+
+const (
+	// RequestIDKey is a predefined constant used as a key for identifying request IDs in structured logging.
+	RequestIDKey = "requestId"
+	// TraceIDKey is a predefined constant used as a key for identifying trace IDs in structured logging.
+	TraceIDKey = "traceId"
+	// SpanIDKey is a predefined constant used as a key for identifying span IDs in structured logging.
+	SpanIDKey = "spanId"
+)
+
+type (
+	contextAttrsKey struct{}
+	requestIDKey    struct{}
+	traceContextKey struct{}
+)
+
+// WithAttrs returns a copy of the context carrying the given attributes, in addition to the attributes already stored in the context.
+// The attributes are added to every record logged with this context (for example with logger.InfoContext(ctx, ...)),
+// when the logger is decorated with ContextAttrsDecorator.
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if len(attrs) == 0 {
+		return ctx
+	}
+	existing := AttrsFromContext(ctx)
+	return context.WithValue(ctx, contextAttrsKey{}, append(existing[:len(existing):len(existing)], attrs...))
+}
+
+// AttrsFromContext returns the attributes stored in the context with WithAttrs.
+func AttrsFromContext(ctx context.Context) []slog.Attr {
+	attrs, _ := ctx.Value(contextAttrsKey{}).([]slog.Attr)
+	return attrs
+}
+
+// WithRequestID returns a copy of the context carrying the given request ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in the context with WithRequestID.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// ErrInvalidTraceParent is returned when the traceparent header doesn't conform to the W3C Trace Context specification.
+var ErrInvalidTraceParent = errors.New("invalid traceparent")
+
+// TraceContext is the identification of the trace and the span, as propagated in W3C traceparent header.
+type TraceContext struct {
+	// TraceID is the 32 hex characters identifier of the whole trace.
+	TraceID string
+	// SpanID is the 16 hex characters identifier of the span (parent-id in the traceparent header).
+	SpanID string
+	// Sampled is the sampled flag of the trace.
+	Sampled bool
+}
+
+// ParseTraceParent parses the value of W3C traceparent header, for example: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01".
+func ParseTraceParent(traceParent string) (TraceContext, error) {
+	parts := strings.Split(strings.TrimSpace(traceParent), "-")
+	if len(parts) < 4 {
+		return TraceContext{}, fmt.Errorf("%w: expected 4 parts separated with '-', got %q", ErrInvalidTraceParent, traceParent)
+	}
+
+	version, traceID, spanID, flags := parts[0], parts[1], parts[2], parts[3]
+	switch {
+	case !isLowerHex(version, 2) || version == "ff":
+		return TraceContext{}, fmt.Errorf("%w: unsupported version %q", ErrInvalidTraceParent, version)
+	case version == "00" && len(parts) != 4:
+		return TraceContext{}, fmt.Errorf("%w: unexpected parts after flags in %q", ErrInvalidTraceParent, traceParent)
+	case !isLowerHex(traceID, 32) || isAllZeros(traceID):
+		return TraceContext{}, fmt.Errorf("%w: invalid trace id %q", ErrInvalidTraceParent, traceID)
+	case !isLowerHex(spanID, 16) || isAllZeros(spanID):
+		return TraceContext{}, fmt.Errorf("%w: invalid parent id %q", ErrInvalidTraceParent, spanID)
+	case !isLowerHex(flags, 2):
+		return TraceContext{}, fmt.Errorf("%w: invalid flags %q", ErrInvalidTraceParent, flags)
+	}
+
+	flagsValue, _ := hex.DecodeString(flags)
+	return TraceContext{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Sampled: flagsValue[0]&0x01 == 0x01,
+	}, nil
+}
+
+// String returns the trace context formatted as the value of W3C traceparent header.
+func (tc TraceContext) String() string {
+	flags := "00"
+	if tc.Sampled {
+		flags = "01"
+	}
+	return "00-" + tc.TraceID + "-" + tc.SpanID + "-" + flags
+}
+
+// WithTraceContext returns a copy of the context carrying the given trace context.
+func WithTraceContext(ctx context.Context, traceContext TraceContext) context.Context {
+	return context.WithValue(ctx, traceContextKey{}, traceContext)
+}
+
+// TraceContextFromContext returns the trace context stored in the context with WithTraceContext.
+func TraceContextFromContext(ctx context.Context) (TraceContext, bool) {
+	traceContext, ok := ctx.Value(traceContextKey{}).(TraceContext)
+	return traceContext, ok
+}
+
+// ContextExtractor returns the attributes extracted from the context, which should be added to the logged record.
+// It's used by ContextAttrsDecorator to integrate values stored in the context by other libraries (like tracing).
+type ContextExtractor func(ctx context.Context) []slog.Attr
+
+// ExtractRequestID is a ContextExtractor returning the request ID stored with WithRequestID as RequestIDKey attribute.
+func ExtractRequestID(ctx context.Context) []slog.Attr {
+	requestID, ok := RequestIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return []slog.Attr{slog.String(RequestIDKey, requestID)}
+}
+
+// ExtractTraceContext is a ContextExtractor returning the trace context stored with WithTraceContext
+// as TraceIDKey and SpanIDKey attributes.
+func ExtractTraceContext(ctx context.Context) []slog.Attr {
+	traceContext, ok := TraceContextFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return []slog.Attr{slog.String(TraceIDKey, traceContext.TraceID), slog.String(SpanIDKey, traceContext.SpanID)}
+}
+
+// ContextAttrsOptions is a set of options for the ContextAttrsDecorator.
+type ContextAttrsOptions struct {
+	extractors []ContextExtractor
+}
+
+// WithContextExtractors adds extractors of the attributes from the context.
+// The extracted attributes are added to the record after the attributes stored with WithAttrs.
+func WithContextExtractors(extractors ...ContextExtractor) func(*ContextAttrsOptions) {
+	return func(options *ContextAttrsOptions) {
+		options.extractors = append(options.extractors, extractors...)
+	}
+}
+
+// WithoutDefaultContextExtractors removes the default extractors (ExtractRequestID and ExtractTraceContext),
+// so only the extractors added after this option are used.
+func WithoutDefaultContextExtractors() func(*ContextAttrsOptions) {
+	return func(options *ContextAttrsOptions) {
+		options.extractors = nil
+	}
+}
+
+// ContextAttrsDecorator is a HandlerDecorator that adds the attributes stored in the context with WithAttrs
+// and the attributes returned by the extractors (by default: request ID and trace context) to every record logged with the context.
+//
+// Keep in mind that the attributes are added to the record, so when the logger has a group (logger.WithGroup),
+// they are nested in that group.
+//
+// The attributes from the context are skipped when the record or the logger (in its current group) already has
+// an attribute with the same key, so for example the request ID added explicitly by the HTTP logging middleware isn't duplicated.
+type ContextAttrsDecorator struct {
+	extractors []ContextExtractor
+}
+
+// NewContextAttrsDecorator creates a new ContextAttrsDecorator configured with the given options.
+func NewContextAttrsDecorator(opts ...func(*ContextAttrsOptions)) *ContextAttrsDecorator {
+	options := to.OptionsWithDefault(ContextAttrsOptions{
+		extractors: []ContextExtractor{ExtractRequestID, ExtractTraceContext},
+	}, opts...)
+
+	return &ContextAttrsDecorator{extractors: options.extractors}
+}
+
+// DecorateHandler decorates the given handler with adding the attributes from the context.
+func (d *ContextAttrsDecorator) DecorateHandler(handler slog.Handler, _ *DecoratorOptions) slog.Handler {
+	return &contextAttrsHandler{next: handler, decorator: d}
+}
+
+func (d *ContextAttrsDecorator) attrsOf(ctx context.Context) []slog.Attr {
+	attrs := AttrsFromContext(ctx)
+	for _, extract := range d.extractors {
+		if extracted := extract(ctx); len(extracted) > 0 {
+			attrs = append(attrs[:len(attrs):len(attrs)], extracted...)
+		}
+	}
+	return attrs
+}
+
+type contextAttrsHandler struct {
+	next      slog.Handler
+	decorator *ContextAttrsDecorator
+	// keys of the attributes added to the logger in its current group
+	keys []string
+}
+
+// Enabled returns true if the next handler is enabled for the level.
+func (h *contextAttrsHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle adds the attributes from the context to the record and passes it to the next handler.
+func (h *contextAttrsHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx == nil {
+		return h.next.Handle(ctx, record) //nolint:wrapcheck
+	}
+
+	attrs := h.missingAttrs(record, h.decorator.attrsOf(ctx))
+	if len(attrs) > 0 {
+		// the record may share its attributes with the caller, so it mustn't be modified in place
+		record = record.Clone()
+		record.AddAttrs(attrs...)
+	}
+	return h.next.Handle(ctx, record) //nolint:wrapcheck
+}
+
+// WithAttrs returns a new handler with the given attributes.
+func (h *contextAttrsHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	keys := h.keys[:len(h.keys):len(h.keys)]
+	for _, attr := range attrs {
+		keys = append(keys, attr.Key)
+	}
+	return &contextAttrsHandler{next: h.next.WithAttrs(attrs), decorator: h.decorator, keys: keys}
+}
+
+// WithGroup returns a new handler with the given group name.
+func (h *contextAttrsHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	// the attributes from the context are nested in the group, so they can't collide with the attributes added so far
+	return &contextAttrsHandler{next: h.next.WithGroup(name), decorator: h.decorator}
+}
+
+// missingAttrs returns the attributes whose keys are present neither in the record nor in the logger attributes.
+func (h *contextAttrsHandler) missingAttrs(record slog.Record, attrs []slog.Attr) []slog.Attr {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	isPresent := func(key string) bool {
+		if slices.Contains(h.keys, key) {
+			return true
+		}
+		found := false
+		record.Attrs(func(attr slog.Attr) bool {
+			found = attr.Key == key
+			return !found
+		})
+		return found
+	}
+
+	missing := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		if !isPresent(attr.Key) {
+			missing = append(missing, attr)
+		}
+	}
+	return missing
+}
+
+func isLowerHex(s string, length int) bool {
+	if len(s) != length {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAllZeros(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
@@ -0,0 +1,69 @@
+package slogx_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleWithAttrs() {
+	logger := slogx.NewLogger(
+		skipTimeInLogOutputForExamplePurposes,
+		slogx.WithDecorator(slogx.NewContextAttrsDecorator()),
+	)
+
+	ctx := slogx.WithAttrs(context.Background(), slog.String("tenant", "acme"))
+	ctx = slogx.WithRequestID(ctx, "req-123")
+
+	logger.InfoContext(ctx, "order created", "orderId", 42)
+	logger.Info("without context")
+
+	// Output:
+	// level=INFO msg="order created" orderId=42 tenant=acme requestId=req-123
+	// level=INFO msg="without context"
+}
+
+func ExampleParseTraceParent() {
+	logger := slogx.NewLogger(
+		skipTimeInLogOutputForExamplePurposes,
+		slogx.WithDecorator(slogx.NewContextAttrsDecorator()),
+	)
+
+	traceContext, err := slogx.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	ctx := slogx.WithTraceContext(context.Background(), traceContext)
+
+	logger.InfoContext(ctx, "payment processed")
+	fmt.Println("sampled:", traceContext.Sampled)
+
+	// Output:
+	// level=INFO msg="payment processed" traceId=4bf92f3577b34da6a3ce929d0e0e4736 spanId=00f067aa0ba902b7
+	// sampled: true
+}
+
+func ExampleWithContextExtractors() {
+	type tenantKey struct{}
+
+	extractTenant := func(ctx context.Context) []slog.Attr {
+		if tenant, ok := ctx.Value(tenantKey{}).(string); ok {
+			return []slog.Attr{slog.String("tenant", tenant)}
+		}
+		return nil
+	}
+
+	logger := slogx.NewLogger(
+		skipTimeInLogOutputForExamplePurposes,
+		slogx.WithDecorator(slogx.NewContextAttrsDecorator(slogx.WithContextExtractors(extractTenant))),
+	)
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	logger.InfoContext(ctx, "invoice sent")
+
+	// Output:
+	// level=INFO msg="invoice sent" tenant=acme
+}
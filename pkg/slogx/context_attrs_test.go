@@ -0,0 +1,148 @@
+package slogx_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func TestWithAttrsDoesNotAffectParentContext(t *testing.T) {
+	// given:
+	parent := slogx.WithAttrs(t.Context(), slog.String("a", "1"))
+
+	// when:
+	first := slogx.WithAttrs(parent, slog.String("b", "2"))
+	second := slogx.WithAttrs(parent, slog.String("c", "3"))
+
+	// then:
+	assert.Equal(t, []slog.Attr{slog.String("a", "1")}, slogx.AttrsFromContext(parent))
+	assert.Equal(t, []slog.Attr{slog.String("a", "1"), slog.String("b", "2")}, slogx.AttrsFromContext(first))
+	assert.Equal(t, []slog.Attr{slog.String("a", "1"), slog.String("c", "3")}, slogx.AttrsFromContext(second))
+}
+
+func TestContextAttrsDecorator(t *testing.T) {
+	t.Run("adds attributes to records of loggers with attrs and groups", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		logger := slogx.NewLogger(
+			slogx.WithWriter(output),
+			slogx.WithFormat(slogx.TextWithoutTimeFormat),
+			slogx.WithDecorator(slogx.NewContextAttrsDecorator()),
+		).With(slogx.Service("orders")).WithGroup("order")
+		ctx := slogx.WithRequestID(t.Context(), "req-1")
+
+		// when:
+		logger.InfoContext(ctx, "created", "id", 1)
+
+		// then:
+		assert.Equal(t, "level=INFO msg=created service=orders order.id=1 order.requestId=req-1\n", output.String())
+	})
+
+	t.Run("without default extractors", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		logger := slogx.NewLogger(
+			slogx.WithWriter(output),
+			slogx.WithFormat(slogx.TextWithoutTimeFormat),
+			slogx.WithDecorator(slogx.NewContextAttrsDecorator(slogx.WithoutDefaultContextExtractors())),
+		)
+		ctx := slogx.WithRequestID(slogx.WithAttrs(t.Context(), slog.Int("attempt", 2)), "req-1")
+
+		// when:
+		logger.InfoContext(ctx, "retry")
+
+		// then:
+		assert.Equal(t, "level=INFO msg=retry attempt=2\n", output.String())
+	})
+
+	t.Run("does not modify the record shared with other handlers", func(t *testing.T) {
+		// given:
+		decorated := slogx.NewCollectingLogsWriter()
+		plain := slogx.NewCollectingLogsWriter()
+		logger := slog.New(slogx.NewMultiHandler(
+			slogx.NewContextAttrsDecorator().DecorateHandler(slog.NewTextHandler(decorated, nil), nil),
+			slog.NewTextHandler(plain, nil),
+		))
+		ctx := slogx.WithAttrs(context.Background(), slog.String("tenant", "acme"))
+
+		// when:
+		logger.InfoContext(ctx, "msg", "a", 1, "b", 2, "c", 3, "d", 4, "e", 5, "f", 6)
+
+		// then:
+		assert.Contains(t, decorated.String(), "tenant=acme")
+		assert.NotContains(t, plain.String(), "tenant=acme")
+	})
+
+	t.Run("skips attributes already present in record or logger", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		logger := slogx.NewLogger(
+			slogx.WithWriter(output),
+			slogx.WithFormat(slogx.TextWithoutTimeFormat),
+			slogx.WithDecorator(slogx.NewContextAttrsDecorator()),
+		).With(slogx.RequestIDKey, "req-1")
+		ctx := slogx.WithRequestID(slogx.WithAttrs(t.Context(), slog.String("tenant", "acme")), "req-1")
+
+		// when:
+		logger.InfoContext(ctx, "first", "tenant", "other")
+		logger.WithGroup("order").InfoContext(ctx, "second")
+
+		// then:
+		assert.Equal(t, "level=INFO msg=first requestId=req-1 tenant=other\n"+
+			"level=INFO msg=second requestId=req-1 order.tenant=acme order.requestId=req-1\n", output.String())
+	})
+}
+
+func TestParseTraceParent(t *testing.T) {
+	validCases := map[string]slogx.TraceContext{
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01": {
+			TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: true,
+		},
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00": {
+			TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7",
+		},
+		"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03-future": {
+			TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7", Sampled: true,
+		},
+	}
+	for traceParent, expected := range validCases {
+		t.Run(traceParent, func(t *testing.T) {
+			traceContext, err := slogx.ParseTraceParent(traceParent)
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, traceContext)
+		})
+	}
+
+	invalidCases := []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-0x",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	}
+	for _, traceParent := range invalidCases {
+		t.Run("invalid "+traceParent, func(t *testing.T) {
+			_, err := slogx.ParseTraceParent(traceParent)
+
+			require.ErrorIs(t, err, slogx.ErrInvalidTraceParent)
+		})
+	}
+}
+
+func TestTraceContextString(t *testing.T) {
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	traceContext, err := slogx.ParseTraceParent(traceParent)
+
+	require.NoError(t, err)
+	assert.Equal(t, traceParent, traceContext.String())
+}
@@ -0,0 +1,416 @@
+package slogx
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	randv2 "math/rand/v2"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+// Keys of the attributes logged by the HTTP logging middleware.
+const (
+	HTTPMethodKey      = "method"
+	HTTPPathKey        = "path"
+	HTTPStatusKey      = "status"
+	HTTPBytesKey       = "bytes"
+	HTTPDurationKey    = "duration"
+	HTTPRemoteIPKey    = "remoteIp"
+	HTTPRequestBodyKey = "requestBody"
+	HTTPPanicKey       = "panic"
+)
+
+// HTTPRequestMessage is the message of the records logged by the HTTP logging middleware.
+const HTTPRequestMessage = "HTTP request"
+
+const (
+	defaultHTTPService  = "http"
+	traceParentHeader   = "Traceparent"
+	requestIDByteLength = 8
+	maxRequestIDLength  = 128
+)
+
+type loggerKey struct{}
+
+// ContextWithLogger returns a copy of the context carrying the given logger.
+func ContextWithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// LoggerFromContext returns the logger stored in the context with ContextWithLogger (for example by the HTTP logging middleware).
+// If there is no logger in the context, it returns the default slog logger.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	logger, _ := ctx.Value(loggerKey{}).(*slog.Logger)
+	return DefaultIfNil(logger)
+}
+
+// DefaultHTTPLevelForStatus returns error level for 5xx status codes, warn level for 4xx and info level for others.
+func DefaultHTTPLevelForStatus(status int) slog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return slog.LevelError
+	case status >= http.StatusBadRequest:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// HTTPMiddlewareOptions is a set of options for the HTTP logging middleware.
+type HTTPMiddlewareOptions struct {
+	serviceName     string
+	levelForStatus  func(status int) slog.Level
+	excludedPaths   []string
+	requestIDHeader string
+	remoteIPHeader  string
+	bodySampleRate  float64
+	bodyMaxBytes    int
+	bodyRedaction   *RedactionDecorator
+}
+
+// WithHTTPServiceName sets the service name of the child logger used by the middleware, by default it's "http".
+func WithHTTPServiceName(serviceName string) func(*HTTPMiddlewareOptions) {
+	return func(options *HTTPMiddlewareOptions) {
+		options.serviceName = serviceName
+	}
+}
+
+// WithHTTPLevelForStatus sets the function choosing the level of the request record by the response status code.
+// By default, it's DefaultHTTPLevelForStatus.
+func WithHTTPLevelForStatus(levelForStatus func(status int) slog.Level) func(*HTTPMiddlewareOptions) {
+	return func(options *HTTPMiddlewareOptions) {
+		options.levelForStatus = levelForStatus
+	}
+}
+
+// WithHTTPExcludedPaths sets the paths of requests which are not logged, like health checks.
+// Patterns are matched with path.Match rules, for example: "/health", "/metrics" or "/debug/*".
+// The logger is still added to the context of excluded requests.
+func WithHTTPExcludedPaths(patterns ...string) func(*HTTPMiddlewareOptions) {
+	return func(options *HTTPMiddlewareOptions) {
+		options.excludedPaths = append(options.excludedPaths, patterns...)
+	}
+}
+
+// WithHTTPRequestIDHeader sets the header with the request ID, by default it's "X-Request-Id".
+// When the request doesn't have the header, or its value is longer than 128 characters or contains characters
+// other than ASCII letters, digits, '.', '_' and '-', a random request ID is generated.
+// The request ID is also set in the response header.
+func WithHTTPRequestIDHeader(header string) func(*HTTPMiddlewareOptions) {
+	return func(options *HTTPMiddlewareOptions) {
+		options.requestIDHeader = header
+	}
+}
+
+// WithHTTPRemoteIPHeader sets the header with the client IP set by a trusted proxy, like "X-Forwarded-For" or "X-Real-Ip".
+// The first address from the header is used. By default, the remote IP is taken from the connection address.
+// Use it only when the service is behind a proxy which sets this header, otherwise the client can spoof its IP.
+func WithHTTPRemoteIPHeader(header string) func(*HTTPMiddlewareOptions) {
+	return func(options *HTTPMiddlewareOptions) {
+		options.remoteIPHeader = header
+	}
+}
+
+// WithHTTPBodySampling enables logging of request bodies for the given fraction (0 to 1) of requests,
+// up to maxBytes of each body.
+// Bodies are redacted with RedactionDecorator with default options, use WithHTTPBodyRedaction to change it.
+// JSON bodies and form bodies (with Content-Type application/x-www-form-urlencoded) are logged as groups,
+// so their sensitive keys are redacted as well. Other bodies, including the JSON bodies truncated to maxBytes,
+// can't be redacted by the keys, so they are redacted as a whole.
+func WithHTTPBodySampling(rate float64, maxBytes int) func(*HTTPMiddlewareOptions) {
+	return func(options *HTTPMiddlewareOptions) {
+		options.bodySampleRate = rate
+		options.bodyMaxBytes = maxBytes
+	}
+}
+
+// WithHTTPBodyRedaction sets the redaction of the sampled request bodies.
+func WithHTTPBodyRedaction(redaction *RedactionDecorator) func(*HTTPMiddlewareOptions) {
+	return func(options *HTTPMiddlewareOptions) {
+		options.bodyRedaction = redaction
+	}
+}
+
+// NewHTTPLoggingMiddleware creates a net/http middleware that logs every request with its method, path, status code,
+// number of written bytes, duration, remote IP and request ID.
+//
+// The middleware stores in the request context a child logger (see Child) with the request ID attribute,
+// which can be retrieved with LoggerFromContext. It also stores the request ID (see WithRequestID)
+// and the trace context from the W3C traceparent header (see WithTraceContext), so they can be added to records
+// by ContextAttrsDecorator. The decorator doesn't duplicate the request ID already added by the middleware
+// to the access log and to the child logger.
+func NewHTTPLoggingMiddleware(logger *slog.Logger, opts ...func(*HTTPMiddlewareOptions)) func(http.Handler) http.Handler {
+	options := to.OptionsWithDefault(HTTPMiddlewareOptions{
+		serviceName:     defaultHTTPService,
+		levelForStatus:  DefaultHTTPLevelForStatus,
+		requestIDHeader: "X-Request-Id",
+	}, opts...)
+	if options.bodySampleRate > 0 && options.bodyRedaction == nil {
+		options.bodyRedaction = NewRedactionDecorator()
+	}
+
+	m := &httpLoggingMiddleware{
+		logger:  Child(logger, options.serviceName),
+		options: options,
+	}
+	return m.wrap
+}
+
+type httpLoggingMiddleware struct {
+	logger  *slog.Logger
+	options HTTPMiddlewareOptions
+}
+
+func (m *httpLoggingMiddleware) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		requestID := r.Header.Get(m.options.requestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = newRequestID()
+		}
+		w.Header().Set(m.options.requestIDHeader, requestID)
+
+		ctx := WithRequestID(r.Context(), requestID)
+		if traceContext, err := ParseTraceParent(r.Header.Get(traceParentHeader)); err == nil {
+			ctx = WithTraceContext(ctx, traceContext)
+		}
+		ctx = ContextWithLogger(ctx, m.logger.With(RequestIDKey, requestID))
+		r = r.WithContext(ctx)
+
+		if m.isExcluded(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var body *slog.Attr
+		if m.shouldSampleBody(r) {
+			body = m.sampleBody(r)
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w}
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				m.log(ctx, r, recorder, http.StatusInternalServerError, requestID, time.Since(start), body, slog.Any(HTTPPanicKey, recovered))
+				panic(recovered)
+			}
+			m.log(ctx, r, recorder, recorder.statusOrDefault(), requestID, time.Since(start), body)
+		}()
+
+		next.ServeHTTP(recorder, r)
+	})
+}
+
+func (m *httpLoggingMiddleware) log(ctx context.Context, r *http.Request, recorder *responseRecorder, status int, requestID string, duration time.Duration, body *slog.Attr, extra ...slog.Attr) {
+	level := m.options.levelForStatus(status)
+	if !m.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String(HTTPMethodKey, r.Method),
+		slog.String(HTTPPathKey, r.URL.Path),
+		slog.Int(HTTPStatusKey, status),
+		slog.Int64(HTTPBytesKey, recorder.bytes),
+		slog.Duration(HTTPDurationKey, duration),
+		slog.String(HTTPRemoteIPKey, m.remoteIP(r)),
+		slog.String(RequestIDKey, requestID),
+	}
+	if body != nil {
+		attrs = append(attrs, *body)
+	}
+	attrs = append(attrs, extra...)
+
+	m.logger.LogAttrs(ctx, level, HTTPRequestMessage, attrs...)
+}
+
+func (m *httpLoggingMiddleware) isExcluded(requestPath string) bool {
+	return slices.ContainsFunc(m.options.excludedPaths, func(pattern string) bool {
+		matched, err := path.Match(pattern, requestPath)
+		return err == nil && matched
+	})
+}
+
+func (m *httpLoggingMiddleware) remoteIP(r *http.Request) string {
+	if m.options.remoteIPHeader != "" {
+		if forwarded := r.Header.Get(m.options.remoteIPHeader); forwarded != "" {
+			first, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (m *httpLoggingMiddleware) shouldSampleBody(r *http.Request) bool {
+	if m.options.bodySampleRate <= 0 || m.options.bodyMaxBytes <= 0 || r.Body == nil || r.Body == http.NoBody {
+		return false
+	}
+	return m.options.bodySampleRate >= 1 || randv2.Float64() < m.options.bodySampleRate //nolint:gosec
+}
+
+// sampleBody reads the beginning of the request body and restores it, so the handler can read the whole body.
+func (m *httpLoggingMiddleware) sampleBody(r *http.Request) *slog.Attr {
+	sample, err := io.ReadAll(io.LimitReader(r.Body, int64(m.options.bodyMaxBytes)))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(sample), r.Body), Closer: r.Body}
+	if err != nil || len(sample) == 0 {
+		return nil
+	}
+
+	redaction := m.options.bodyRedaction
+	decoded, ok := decodeBody(r.Header.Get("Content-Type"), sample)
+	if !ok {
+		// sensitive keys can't be found in the body which can't be parsed, so it mustn't be logged as is
+		attr := slog.String(HTTPRequestBodyKey, redaction.strategy(string(sample)))
+		return &attr
+	}
+
+	redacted := redaction.redactAttr(nil, slog.Attr{Key: HTTPRequestBodyKey, Value: jsonToLogValue(decoded)})
+	return &redacted
+}
+
+// decodeBody decodes the form or JSON body to the values accepted by jsonToLogValue,
+// it returns false if the body can't be decoded, for example because it's truncated.
+func decodeBody(contentType string, body []byte) (any, bool) {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType == "application/x-www-form-urlencoded" {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, false
+		}
+		decoded := make(map[string]any, len(values))
+		for key, list := range values {
+			if len(list) == 1 {
+				decoded[key] = list[0]
+				continue
+			}
+			elems := make([]any, 0, len(list))
+			for _, value := range list {
+				elems = append(elems, value)
+			}
+			decoded[key] = elems
+		}
+		return decoded, true
+	}
+
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return nil, false
+	}
+	return decoded, true
+}
+
+// jsonToLogValue converts the decoded JSON value to slog.Value, with objects and arrays converted to groups,
+// so they can be redacted by the keys.
+func jsonToLogValue(value any) slog.Value {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		attrs := make([]slog.Attr, 0, len(v))
+		for _, key := range keys {
+			attrs = append(attrs, slog.Attr{Key: key, Value: jsonToLogValue(v[key])})
+		}
+		return slog.GroupValue(attrs...)
+	case []any:
+		attrs := make([]slog.Attr, 0, len(v))
+		for i, elem := range v {
+			attrs = append(attrs, slog.Attr{Key: strconv.Itoa(i), Value: jsonToLogValue(elem)})
+		}
+		return slog.GroupValue(attrs...)
+	default:
+		return slog.AnyValue(v)
+	}
+}
+
+// isValidRequestID returns true if the request ID provided by the client is safe to be logged and returned in the response.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '.' && c != '_' && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	id := make([]byte, requestIDByteLength)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// responseRecorder records the status code and the number of bytes written to the response.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+// WriteHeader records the status code and passes it to the wrapped writer.
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the number of written bytes and passes the data to the wrapped writer.
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(data)
+	r.bytes += int64(n)
+	return n, err //nolint:wrapcheck
+}
+
+// Flush implements http.Flusher interface, if the wrapped writer supports it.
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		if r.status == 0 {
+			r.status = http.StatusOK
+		}
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the wrapped writer, so http.ResponseController can access its features.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *responseRecorder) statusOrDefault() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+	return r.status
+}
@@ -0,0 +1,68 @@
+package slogx_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+// exampleHTTPLogger returns a logger writing to stdout without the time and duration, which change on each run.
+func exampleHTTPLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey || attr.Key == slogx.HTTPDurationKey {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}))
+}
+
+func ExampleNewHTTPLoggingMiddleware() {
+	middleware := slogx.NewHTTPLoggingMiddleware(
+		exampleHTTPLogger(),
+		slogx.WithHTTPExcludedPaths("/health"),
+	)
+
+	handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/orders/404" {
+			slogx.LoggerFromContext(r.Context()).Info("order not found")
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	for _, target := range []string{"/health", "/orders/1", "/orders/404"} {
+		request := httptest.NewRequest(http.MethodGet, target, nil)
+		request.Header.Set("X-Request-Id", "req-"+strings.TrimPrefix(target, "/orders/"))
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	// Output:
+	// level=INFO msg="HTTP request" service=http method=GET path=/orders/1 status=200 bytes=2 remoteIp=192.0.2.1 requestId=req-1
+	// level=INFO msg="order not found" service=http requestId=req-404
+	// level=WARN msg="HTTP request" service=http method=GET path=/orders/404 status=404 bytes=19 remoteIp=192.0.2.1 requestId=req-404
+}
+
+func ExampleWithHTTPBodySampling() {
+	middleware := slogx.NewHTTPLoggingMiddleware(
+		exampleHTTPLogger(),
+		slogx.WithHTTPBodySampling(1, 1024),
+	)
+
+	handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	request := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"user":"john","password":"hunter2"}`))
+	request.Header.Set("X-Request-Id", "req-1")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	// Output:
+	// level=INFO msg="HTTP request" service=http method=POST path=/login status=201 bytes=0 remoteIp=192.0.2.1 requestId=req-1 requestBody.password=[REDACTED] requestBody.user=john
+}
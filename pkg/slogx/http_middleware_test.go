@@ -0,0 +1,306 @@
+package slogx_test
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func newHTTPTestLogger(output *slogx.CollectingLogsWriter) *slog.Logger {
+	return slogx.NewLogger(
+		slogx.WithWriter(output),
+		slogx.WithFormat(slogx.TextWithoutTimeFormat),
+		slogx.WithLevel(slogx.LogLevelDebug),
+		slogx.WithDecorator(slogx.NewContextAttrsDecorator()),
+	)
+}
+
+func TestHTTPLoggingMiddlewareRequestID(t *testing.T) {
+	t.Run("generates request ID when header is missing", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		var requestID string
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output))(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			requestID, _ = slogx.RequestIDFromContext(r.Context())
+		}))
+		response := httptest.NewRecorder()
+
+		// when:
+		handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		// then:
+		assert.Len(t, requestID, 16)
+		assert.Equal(t, requestID, response.Header().Get("X-Request-Id"))
+		assert.Contains(t, output.String(), "requestId="+requestID)
+	})
+
+	t.Run("uses custom header", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output), slogx.WithHTTPRequestIDHeader("X-Correlation-Id"))(http.NotFoundHandler())
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("X-Correlation-Id", "abc")
+		response := httptest.NewRecorder()
+
+		// when:
+		handler.ServeHTTP(response, request)
+
+		// then:
+		assert.Equal(t, "abc", response.Header().Get("X-Correlation-Id"))
+		assert.Contains(t, output.String(), "requestId=abc")
+	})
+
+	for name, invalid := range map[string]string{
+		"too long":           strings.Repeat("a", 129),
+		"invalid characters": "abc def\nlevel=ERROR",
+	} {
+		t.Run("replaces request ID with "+name, func(t *testing.T) {
+			// given:
+			output := slogx.NewCollectingLogsWriter()
+			handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output))(http.NotFoundHandler())
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set("X-Request-Id", invalid)
+			response := httptest.NewRecorder()
+
+			// when:
+			handler.ServeHTTP(response, request)
+
+			// then:
+			requestID := response.Header().Get("X-Request-Id")
+			assert.Len(t, requestID, 16)
+			assert.Contains(t, output.String(), "requestId="+requestID)
+			assert.NotContains(t, output.String(), invalid)
+		})
+	}
+
+	t.Run("logs request ID once with context attrs decorator", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output))(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			logger := slogx.LoggerFromContext(r.Context())
+			logger.Info("without context")
+			logger.InfoContext(r.Context(), "with context")
+		}))
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("X-Request-Id", "abc")
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		// then:
+		lines := output.Lines()
+		require.Len(t, lines, 3)
+		for _, line := range lines {
+			assert.Equal(t, 1, strings.Count(line, "requestId=abc"), line)
+		}
+	})
+}
+
+func TestHTTPLoggingMiddlewareTraceContext(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output))(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		slog.New(slogx.NewContextAttrsDecorator(slogx.WithoutDefaultContextExtractors(), slogx.WithContextExtractors(slogx.ExtractTraceContext)).
+			DecorateHandler(slog.NewTextHandler(output, nil), nil)).InfoContext(r.Context(), "inside")
+	}))
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	// when:
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	// then:
+	assert.Contains(t, output.String(), "msg=inside traceId=4bf92f3577b34da6a3ce929d0e0e4736 spanId=00f067aa0ba902b7")
+}
+
+func TestHTTPLoggingMiddlewareLevels(t *testing.T) {
+	cases := map[int]string{
+		http.StatusOK:                  "level=INFO",
+		http.StatusFound:               "level=INFO",
+		http.StatusBadRequest:          "level=WARN",
+		http.StatusInternalServerError: "level=ERROR",
+	}
+	for status, expectedLevel := range cases {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			// given:
+			output := slogx.NewCollectingLogsWriter()
+			handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(status)
+			}))
+
+			// when:
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+			// then:
+			assert.Contains(t, output.String(), expectedLevel)
+		})
+	}
+
+	t.Run("custom level selection", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(
+			newHTTPTestLogger(output),
+			slogx.WithHTTPLevelForStatus(func(int) slog.Level { return slog.LevelDebug }),
+		)(http.NotFoundHandler())
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+		// then:
+		assert.Contains(t, output.String(), "level=DEBUG")
+	})
+}
+
+func TestHTTPLoggingMiddlewareExcludedPaths(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	var hasLogger bool
+	handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output), slogx.WithHTTPExcludedPaths("/health", "/debug/*"))(
+		http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			hasLogger = slogx.LoggerFromContext(r.Context()) != slog.Default()
+		}),
+	)
+
+	// when:
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+
+	// then:
+	assert.Empty(t, output.String())
+	assert.True(t, hasLogger)
+}
+
+func TestHTTPLoggingMiddlewareRemoteIPHeader(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output), slogx.WithHTTPRemoteIPHeader("X-Forwarded-For"))(http.NotFoundHandler())
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+
+	// when:
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	// then:
+	assert.Contains(t, output.String(), "remoteIp=203.0.113.7")
+}
+
+func TestHTTPLoggingMiddlewareBodySampling(t *testing.T) {
+	t.Run("handler reads the whole body", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		var received string
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output), slogx.WithHTTPBodySampling(1, 5))(
+			http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				received = string(body)
+			}),
+		)
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader("hello world")))
+
+		// then:
+		assert.Equal(t, "hello world", received)
+		assert.Contains(t, output.String(), "requestBody=[REDACTED]")
+		assert.NotContains(t, output.String(), "hello")
+	})
+
+	t.Run("redacts keys of form body", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output), slogx.WithHTTPBodySampling(1, 1024))(http.NotFoundHandler())
+		request := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader("user=john&password=s3cret&scope=a&scope=b"))
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		// then:
+		assert.Contains(t, output.String(), "requestBody.password=[REDACTED] requestBody.scope.0=a requestBody.scope.1=b requestBody.user=john")
+		assert.NotContains(t, output.String(), "s3cret")
+	})
+
+	t.Run("redacts truncated JSON body as a whole", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output), slogx.WithHTTPBodySampling(1, 30))(http.NotFoundHandler())
+		body := `{"user":"john","password":"s3cret","remember":true}`
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(body)))
+
+		// then:
+		assert.Contains(t, output.String(), "requestBody=[REDACTED]")
+		assert.NotContains(t, output.String(), "s3c")
+	})
+
+	t.Run("redacts keys of top-level JSON array", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output), slogx.WithHTTPBodySampling(1, 1024))(http.NotFoundHandler())
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"password":"s3cret"}]`)))
+
+		// then:
+		assert.Contains(t, output.String(), "requestBody.0.password=[REDACTED]")
+		assert.NotContains(t, output.String(), "s3cret")
+	})
+
+	t.Run("redacts values with custom redaction", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(
+			newHTTPTestLogger(output),
+			slogx.WithHTTPBodySampling(1, 1024),
+			slogx.WithHTTPBodyRedaction(slogx.NewRedactionDecorator(slogx.WithRedactedValuePatterns(slogx.EmailPattern))),
+		)(http.NotFoundHandler())
+		body := `{"items":[{"token":"t1"}],"email":"john@example.com"}`
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+		// then:
+		assert.Contains(t, output.String(), "requestBody.email=[REDACTED] requestBody.items.0.token=[REDACTED]")
+	})
+
+	t.Run("body is not logged without sampling", func(t *testing.T) {
+		// given:
+		output := slogx.NewCollectingLogsWriter()
+		handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output))(http.NotFoundHandler())
+
+		// when:
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader("hello")))
+
+		// then:
+		assert.NotContains(t, output.String(), "requestBody")
+	})
+}
+
+func TestHTTPLoggingMiddlewareLogsPanics(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	handler := slogx.NewHTTPLoggingMiddleware(newHTTPTestLogger(output))(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+
+	// when:
+	assert.PanicsWithValue(t, "boom", func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+
+	// then:
+	assert.Contains(t, output.String(), "level=ERROR")
+	assert.Contains(t, output.String(), "status=500")
+	assert.Contains(t, output.String(), "panic=boom")
+}
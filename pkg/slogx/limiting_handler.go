@@ -0,0 +1,132 @@
+package slogx
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var _ slog.Handler = (*limitingHandler)(nil)
+
+// DroppedRecordsMessage is the message of the summary record logged by the sampling and rate limiting decorators.
+const DroppedRecordsMessage = "dropped log records"
+
+// DroppedKey is the key of the attribute with the number of dropped records in the summary record.
+const DroppedKey = "dropped"
+
+// recordLimiter decides if the record should be passed to the next handler.
+type recordLimiter interface {
+	allow(record *slog.Record, handlerAttrs []slog.Attr, now time.Time) bool
+}
+
+// droppedSummary counts dropped records and periodically reports them with a summary record.
+type droppedSummary struct {
+	reason     string
+	interval   time.Duration
+	now        func() time.Time
+	dropped    atomic.Uint64
+	total      atomic.Uint64
+	mu         sync.Mutex
+	lastReport time.Time
+}
+
+func newDroppedSummary(reason string, interval time.Duration, now func() time.Time) *droppedSummary {
+	return &droppedSummary{
+		reason:     reason,
+		interval:   interval,
+		now:        now,
+		lastReport: now(),
+	}
+}
+
+func (s *droppedSummary) drop() {
+	s.dropped.Add(1)
+	s.total.Add(1)
+}
+
+// report logs the summary record with the given handler, if the interval has passed since the last report
+// and there are some dropped records.
+func (s *droppedSummary) report(ctx context.Context, handler slog.Handler, now time.Time) error {
+	if s.interval <= 0 || s.dropped.Load() == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	if now.Sub(s.lastReport) < s.interval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastReport = now
+	dropped := s.dropped.Swap(0)
+	s.mu.Unlock()
+
+	if dropped == 0 || !handler.Enabled(ctx, slog.LevelWarn) {
+		return nil
+	}
+
+	record := slog.NewRecord(now, slog.LevelWarn, DroppedRecordsMessage, 0)
+	record.AddAttrs(slog.Uint64(DroppedKey, dropped), slog.String("reason", s.reason))
+	return handler.Handle(ctx, record) //nolint:wrapcheck
+}
+
+type limitingHandler struct {
+	next slog.Handler
+	// root is the decorated handler, used for the summary record, so it doesn't get the groups and attributes of child loggers
+	root    slog.Handler
+	limiter recordLimiter
+	summary *droppedSummary
+	attrs   []slog.Attr
+}
+
+func newLimitingHandler(next slog.Handler, limiter recordLimiter, summary *droppedSummary) *limitingHandler {
+	return &limitingHandler{
+		next:    next,
+		root:    next,
+		limiter: limiter,
+		summary: summary,
+	}
+}
+
+// Enabled returns true if the next handler is enabled for the level.
+func (h *limitingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle passes the record to the next handler, if it's allowed by the limiter.
+func (h *limitingHandler) Handle(ctx context.Context, record slog.Record) error {
+	now := h.summary.now()
+	if err := h.summary.report(ctx, h.root, now); err != nil {
+		return err
+	}
+
+	if !h.limiter.allow(&record, h.attrs, now) {
+		h.summary.drop()
+		return nil
+	}
+
+	return h.next.Handle(ctx, record) //nolint:wrapcheck
+}
+
+// WithAttrs returns a new handler with the given attributes.
+func (h *limitingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &limitingHandler{
+		next:    h.next.WithAttrs(attrs),
+		root:    h.root,
+		limiter: h.limiter,
+		summary: h.summary,
+		attrs:   append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...),
+	}
+}
+
+// WithGroup returns a new handler with the given group name.
+func (h *limitingHandler) WithGroup(name string) slog.Handler {
+	return &limitingHandler{
+		next:    h.next.WithGroup(name),
+		root:    h.root,
+		limiter: h.limiter,
+		summary: h.summary,
+		attrs:   h.attrs,
+	}
+}
@@ -0,0 +1,155 @@
+package slogx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// maxLogLevelAdminBodySize is the limit of the request body size accepted by the log level admin handler.
+const maxLogLevelAdminBodySize = 1 << 20
+
+// LogLevels is the JSON representation of the LogLevelManager configuration, used by the log level admin handler.
+//
+// Levels are represented as strings parseable by ParseLogLevel ("debug", "info", "warn", "error", "none"),
+// in requests they can also be numbers of slog.Level.
+type LogLevels struct {
+	// Level is the default level.
+	Level string `json:"level"`
+	// Patterns are the dot-service-patterns with their levels.
+	Patterns map[string]string `json:"patterns"`
+}
+
+// logLevelsConfig is the body of PUT and PATCH requests, and the content of the log levels file.
+// In PATCH requests, the null level of the pattern removes the pattern.
+type logLevelsConfig struct {
+	Level    any            `json:"level"    yaml:"level"`
+	Patterns map[string]any `json:"patterns" yaml:"patterns"`
+}
+
+type logLevelAdminError struct {
+	Error string `json:"error"`
+}
+
+// NewLogLevelAdminHandler creates an http.Handler for reading and changing the levels of the LogLevelManager at runtime,
+// for example to raise a single component to debug level in production without redeploying it.
+//
+// Supported methods:
+//   - GET returns the current LogLevels.
+//   - PUT replaces the configuration: sets the default level and replaces all the patterns, removing the patterns missing in the body.
+//   - PATCH updates the configuration: sets the default level (if present) and the levels of the given patterns,
+//     a pattern with null level is removed.
+//
+// PUT and PATCH requests take the JSON body like {"level": "info", "patterns": {"Service.Component": "debug"}}
+// and respond with the LogLevels after the change. When the body is invalid, nothing is changed
+// and the response is 400 Bad Request with the JSON body like {"error": "..."}.
+//
+// The handler doesn't authenticate the requests, so it should be exposed only on the admin (internal) port
+// or wrapped with the authentication middleware.
+func NewLogLevelAdminHandler(manager *LogLevelManager) http.Handler {
+	return &logLevelAdminHandler{manager: manager}
+}
+
+type logLevelAdminHandler struct {
+	manager *LogLevelManager
+}
+
+// ServeHTTP handles the request to read or change the log levels.
+func (h *logLevelAdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPut, http.MethodPatch:
+		if err := h.update(w, r); err != nil {
+			writeJSON(w, http.StatusBadRequest, logLevelAdminError{Error: err.Error()})
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT, PATCH")
+		writeJSON(w, http.StatusMethodNotAllowed, logLevelAdminError{Error: fmt.Sprintf("method %s is not allowed", r.Method)})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.current())
+}
+
+func (h *logLevelAdminHandler) current() LogLevels {
+	patterns := make(map[string]string)
+	for pattern, level := range h.manager.ServicePatternLevels() {
+		patterns[pattern] = levelName(level)
+	}
+	return LogLevels{
+		Level:    levelName(h.manager.DefaultLevel()),
+		Patterns: patterns,
+	}
+}
+
+func (h *logLevelAdminHandler) update(w http.ResponseWriter, r *http.Request) error {
+	var update logLevelsConfig
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxLogLevelAdminBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&update); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+
+	replace := r.Method == http.MethodPut
+	if replace && update.Level == nil {
+		return errors.New("default level is required")
+	}
+
+	var removed []string
+	if !replace {
+		for pattern, level := range update.Patterns {
+			if level == nil {
+				removed = append(removed, pattern)
+				delete(update.Patterns, pattern)
+			}
+		}
+	}
+
+	return h.manager.applyLevelsConfig(update, replace, removed)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// applyLevelsConfig validates the config and, when it's valid, sets the default level (if present) and the levels of the patterns,
+// and removes the given patterns (or all the patterns missing in the config, if replaceAll is true) in a single change.
+func (m *LogLevelManager) applyLevelsConfig(config logLevelsConfig, replaceAll bool, removePatterns []string) error {
+	var errs []error
+	var defaultLevel *slog.Level
+	if config.Level != nil {
+		level, err := levelFromAny(config.Level)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid default level: %w", err))
+		} else {
+			defaultLevel = &level
+		}
+	}
+
+	levels := make(map[string]any, len(config.Patterns))
+	for pattern, level := range config.Patterns {
+		if level == nil {
+			errs = append(errs, fmt.Errorf("level for pattern %s is required", pattern))
+			continue
+		}
+		levels[pattern] = level
+	}
+	matchers, err := matchersFromLevels(levels)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if defaultLevel != nil {
+		m.SetLevel(*defaultLevel)
+	}
+	m.updateMatchers(replaceAll, matchers, removePatterns)
+	return nil
+}
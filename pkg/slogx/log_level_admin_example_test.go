@@ -0,0 +1,31 @@
+package slogx_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleNewLogLevelAdminHandler() {
+	manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+	admin := slogx.NewLogLevelAdminHandler(manager)
+
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithLevel(slogx.LogLevelDebug), slogx.WithDecorator(manager))
+	repository := slogx.Child(logger, "Repository")
+
+	repository.Debug("query executed")
+
+	request := httptest.NewRequest(http.MethodPatch, "/admin/log-levels", strings.NewReader(`{"patterns": {"Repository": "debug"}}`))
+	response := httptest.NewRecorder()
+	admin.ServeHTTP(response, request)
+	fmt.Print(response.Code, " ", response.Body.String())
+
+	repository.Debug("query executed")
+
+	// Output:
+	// 200 {"level":"info","patterns":{"Repository":"debug"}}
+	// level=DEBUG msg="query executed" service=Repository
+}
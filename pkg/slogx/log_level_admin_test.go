@@ -0,0 +1,159 @@
+package slogx_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func callLogLevelAdmin(t *testing.T, handler http.Handler, method, body string) (int, map[string]any) {
+	t.Helper()
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(method, "/log-levels", strings.NewReader(body)))
+
+	assert.Equal(t, "application/json", response.Header().Get("Content-Type"))
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &decoded))
+	return response.Code, decoded
+}
+
+func TestLogLevelAdminHandler(t *testing.T) {
+	t.Run("GET returns default level and patterns", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelWarn)
+		require.NoError(t, manager.SetLevels(map[string]any{"A": "debug", "A.B": slogx.LevelNone, "C": slog.LevelWarn + 2}))
+		handler := slogx.NewLogLevelAdminHandler(manager)
+
+		// when:
+		status, body := callLogLevelAdmin(t, handler, http.MethodGet, "")
+
+		// then:
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]any{
+			"level":    "warn",
+			"patterns": map[string]any{"A": "debug", "A.B": "none", "C": "warn+2"},
+		}, body)
+	})
+
+	t.Run("PATCH updates default level, sets and removes patterns", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		require.NoError(t, manager.SetLevels(map[string]any{"A": "debug", "B": "error"}))
+		handler := slogx.NewLogLevelAdminHandler(manager)
+
+		// when:
+		status, body := callLogLevelAdmin(t, handler, http.MethodPatch, `{"level": "error", "patterns": {"A": null, "B": "warn", "C.D": -4}}`)
+
+		// then:
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]any{
+			"level":    "error",
+			"patterns": map[string]any{"B": "warn", "C.D": "debug"},
+		}, body)
+		assert.Equal(t, slog.LevelError, manager.DefaultLevel())
+	})
+
+	t.Run("PATCH without level keeps the default level", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelWarn)
+		handler := slogx.NewLogLevelAdminHandler(manager)
+
+		// when:
+		status, _ := callLogLevelAdmin(t, handler, http.MethodPatch, `{"patterns": {"A": "debug"}}`)
+
+		// then:
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, slog.LevelWarn, manager.DefaultLevel())
+	})
+
+	t.Run("PUT replaces all the patterns", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		require.NoError(t, manager.SetLevels(map[string]any{"A": "debug", "B": "error"}))
+		handler := slogx.NewLogLevelAdminHandler(manager)
+
+		// when:
+		status, body := callLogLevelAdmin(t, handler, http.MethodPut, `{"level": "debug", "patterns": {"B": "info"}}`)
+
+		// then:
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]any{
+			"level":    "debug",
+			"patterns": map[string]any{"B": "info"},
+		}, body)
+	})
+
+	t.Run("levels returned by GET are accepted by PUT", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		require.NoError(t, manager.SetLevels(map[string]any{"A": 2, "B": slog.LevelDebug - 1, "C": slogx.LevelNone}))
+		handler := slogx.NewLogLevelAdminHandler(manager)
+		_, current := callLogLevelAdmin(t, handler, http.MethodGet, "")
+		body, err := json.Marshal(current)
+		require.NoError(t, err)
+
+		// when:
+		status, updated := callLogLevelAdmin(t, handler, http.MethodPut, string(body))
+
+		// then:
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]any{
+			"level":    "info",
+			"patterns": map[string]any{"A": "info+2", "B": "debug-1", "C": "none"},
+		}, updated)
+		assert.Equal(t, map[string]slog.Level{"A": 2, "B": slog.LevelDebug - 1, "C": slogx.LevelNone}, manager.ServicePatternLevels())
+	})
+
+	invalidRequests := map[string]struct {
+		method string
+		body   string
+		errMsg string
+	}{
+		"malformed json":              {method: http.MethodPatch, body: `{"level":`, errMsg: "invalid request body"},
+		"unknown field":               {method: http.MethodPatch, body: `{"levels": {}}`, errMsg: "unknown field"},
+		"invalid default level":       {method: http.MethodPatch, body: `{"level": "verbose"}`, errMsg: "invalid default level"},
+		"invalid pattern level":       {method: http.MethodPatch, body: `{"patterns": {"A": "verbose"}}`, errMsg: "pattern A"},
+		"non integer level":           {method: http.MethodPatch, body: `{"patterns": {"A": 1.5}}`, errMsg: "not an integer"},
+		"blank pattern":               {method: http.MethodPatch, body: `{"patterns": {" ": "debug"}}`, errMsg: "must not be empty"},
+		"PUT without default level":   {method: http.MethodPut, body: `{"patterns": {}}`, errMsg: "default level is required"},
+		"PUT with null pattern level": {method: http.MethodPut, body: `{"level": "info", "patterns": {"A": null}}`, errMsg: "pattern A is required"},
+	}
+	for name, test := range invalidRequests {
+		t.Run("rejects "+name, func(t *testing.T) {
+			// given:
+			manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+			require.NoError(t, manager.SetLevels(map[string]any{"B": "error"}))
+			handler := slogx.NewLogLevelAdminHandler(manager)
+
+			// when:
+			status, body := callLogLevelAdmin(t, handler, test.method, test.body)
+
+			// then:
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Contains(t, body["error"], test.errMsg)
+			assert.Equal(t, slog.LevelInfo, manager.DefaultLevel(), "default level shouldn't change")
+			assert.Equal(t, map[string]slog.Level{"B": slog.LevelError}, manager.ServicePatternLevels(), "patterns shouldn't change")
+		})
+	}
+
+	t.Run("rejects unsupported method", func(t *testing.T) {
+		// given:
+		handler := slogx.NewLogLevelAdminHandler(slogx.NewLogLevelManager(slogx.LogLevelInfo))
+		response := httptest.NewRecorder()
+
+		// when:
+		handler.ServeHTTP(response, httptest.NewRequest(http.MethodDelete, "/log-levels", nil))
+
+		// then:
+		assert.Equal(t, http.StatusMethodNotAllowed, response.Code)
+		assert.Equal(t, "GET, HEAD, PUT, PATCH", response.Header().Get("Allow"))
+	})
+}
@@ -0,0 +1,275 @@
+package slogx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+// DefaultLogLevelEnvPrefix is the default name of the environment variable with the default log level,
+// and the prefix of the environment variables with the levels of the patterns.
+const DefaultLogLevelEnvPrefix = "LOG_LEVEL"
+
+// EnvLevelsOptions is a set of options for LoadLevelsFromEnv.
+type EnvLevelsOptions struct {
+	prefix  string
+	environ func() []string
+}
+
+// WithEnvPrefix sets the name of the environment variable with the default level, by default it's "LOG_LEVEL".
+// The variables with the levels of the patterns are named as the prefix followed by underscore and the pattern.
+func WithEnvPrefix(prefix string) func(*EnvLevelsOptions) {
+	return func(options *EnvLevelsOptions) {
+		options.prefix = prefix
+	}
+}
+
+// WithEnviron sets the function returning the environment variables in "key=value" form, by default it's os.Environ.
+// It is useful for testing.
+func WithEnviron(environ func() []string) func(*EnvLevelsOptions) {
+	return func(options *EnvLevelsOptions) {
+		options.environ = environ
+	}
+}
+
+// LoadLevelsFromEnv sets the levels of the LogLevelManager from the environment variables.
+//
+// The variable named as the prefix (by default "LOG_LEVEL") sets the default level.
+// The variables named as the prefix followed by underscore and the pattern with dots replaced by underscores
+// set the levels of the patterns, for example LOG_LEVEL_Service_Component=debug sets debug level for the pattern "Service.Component".
+// Double underscore stands for the underscore in the pattern, for example LOG_LEVEL_order__service=debug sets the level of "order_service".
+// Values are the level names accepted by ParseLogLevel or integers of slog.Level.
+//
+// The variables with empty values are ignored. Other variables sharing the prefix may have unrelated meaning
+// (like LOG_LEVEL_FORMAT), so the variables with invalid values are skipped and reported in the returned error,
+// while the valid ones are applied anyway.
+func LoadLevelsFromEnv(manager *LogLevelManager, opts ...func(*EnvLevelsOptions)) error {
+	options := to.OptionsWithDefault(EnvLevelsOptions{
+		prefix:  DefaultLogLevelEnvPrefix,
+		environ: os.Environ,
+	}, opts...)
+
+	var errs []error
+	config := logLevelsConfig{Patterns: make(map[string]any)}
+	for _, variable := range options.environ() {
+		name, value, _ := strings.Cut(variable, "=")
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+
+		switch {
+		case name == options.prefix:
+			level := envLevelValue(value)
+			if _, err := levelFromAny(level); err != nil {
+				errs = append(errs, fmt.Errorf("skipped variable %s: %w", name, err))
+				continue
+			}
+			config.Level = level
+		case strings.HasPrefix(name, options.prefix+"_"):
+			pattern := envPatternReplacer.Replace(strings.TrimPrefix(name, options.prefix+"_"))
+			level := envLevelValue(value)
+			if _, err := matchersFromLevels(map[string]any{pattern: level}); err != nil {
+				errs = append(errs, fmt.Errorf("skipped variable %s: %w", name, err))
+				continue
+			}
+			config.Patterns[pattern] = level
+		}
+	}
+
+	if err := manager.applyLevelsConfig(config, false, nil); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to load log levels from environment: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// envPatternReplacer converts the name of the environment variable (without the prefix) to the pattern,
+// double underscore is checked first, so it's replaced by a single underscore instead of two dots.
+var envPatternReplacer = strings.NewReplacer("__", "_", "_", ".")
+
+// envLevelValue returns the integer value of the level if it's a number, otherwise the trimmed string.
+func envLevelValue(value string) any {
+	value = strings.TrimSpace(value)
+	if number, err := strconv.Atoi(value); err == nil {
+		return number
+	}
+	return value
+}
+
+// LoadLevelsFromFile sets the levels of the LogLevelManager from the JSON or YAML file (recognized by .json, .yaml or .yml extension).
+// The file has the same structure as the body of the log level admin handler (see NewLogLevelAdminHandler), for example:
+//
+//	level: info
+//	patterns:
+//	  Service.Component: debug
+//	  OtherService: error
+//
+// The default level is changed only if it's present in the file. The patterns not present in the file are kept.
+// When the file is invalid, nothing is changed.
+func LoadLevelsFromFile(manager *LogLevelManager, path string) error {
+	config, _, err := readLevelsFile(path)
+	if err != nil {
+		return err
+	}
+	if err := manager.applyLevelsConfig(config, false, nil); err != nil {
+		return fmt.Errorf("failed to load log levels from file %s: %w", path, err)
+	}
+	return nil
+}
+
+// LevelsFileWatchOptions is a set of options for WatchLevelsFile.
+type LevelsFileWatchOptions struct {
+	interval time.Duration
+	onError  func(error)
+	onReload func()
+}
+
+// WithLevelsFileWatchInterval sets how often the file is checked for changes, by default it's 10 seconds.
+// The interval must be positive, otherwise WatchLevelsFile returns an error.
+func WithLevelsFileWatchInterval(interval time.Duration) func(*LevelsFileWatchOptions) {
+	return func(options *LevelsFileWatchOptions) {
+		options.interval = interval
+	}
+}
+
+// WithLevelsFileWatchErrorHandler sets the function called when the changed file cannot be read or is invalid.
+// In such case the previous levels are kept, and the file is applied again when it's fixed.
+func WithLevelsFileWatchErrorHandler(onError func(error)) func(*LevelsFileWatchOptions) {
+	return func(options *LevelsFileWatchOptions) {
+		options.onError = onError
+	}
+}
+
+// WithLevelsFileReloadCallback sets the function called after the changed file is applied.
+func WithLevelsFileReloadCallback(onReload func()) func(*LevelsFileWatchOptions) {
+	return func(options *LevelsFileWatchOptions) {
+		options.onReload = onReload
+	}
+}
+
+// WatchLevelsFile loads the levels from the file (see LoadLevelsFromFile) and starts a goroutine polling the file for changes
+// until the context is done.
+//
+// When the file content changes, it's applied atomically: the levels of the patterns are set,
+// and the patterns which were loaded from the previous version of the file, but are missing in the new one, are removed.
+// The patterns set in other ways (for example from the environment or with the admin handler) are kept,
+// unless they are present in the file.
+//
+// It returns an error if the watch interval isn't positive or the initial load fails, in such case the goroutine isn't started.
+func WatchLevelsFile(ctx context.Context, manager *LogLevelManager, path string, opts ...func(*LevelsFileWatchOptions)) error {
+	options := to.OptionsWithDefault(LevelsFileWatchOptions{
+		interval: 10 * time.Second,
+	}, opts...)
+	if options.interval <= 0 {
+		return fmt.Errorf("failed to watch levels file %s: watch interval must be positive, got %s", path, options.interval)
+	}
+
+	watcher := &levelsFileWatcher{
+		manager: manager,
+		path:    path,
+		options: options,
+	}
+	if err := watcher.reload(); err != nil {
+		return err
+	}
+
+	go watcher.watch(ctx)
+	return nil
+}
+
+type levelsFileWatcher struct {
+	manager  *LogLevelManager
+	path     string
+	options  LevelsFileWatchOptions
+	content  []byte
+	patterns []string
+}
+
+func (w *levelsFileWatcher) watch(ctx context.Context) {
+	ticker := time.NewTicker(w.options.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.reload(); err != nil && w.options.onError != nil {
+				w.options.onError(err)
+			}
+		}
+	}
+}
+
+// reload applies the file if its content has changed since the last successful reload.
+func (w *levelsFileWatcher) reload() error {
+	config, content, err := readLevelsFile(w.path)
+	if err != nil {
+		return err
+	}
+	if w.content != nil && bytes.Equal(content, w.content) {
+		return nil
+	}
+
+	removed := slices.DeleteFunc(slices.Clone(w.patterns), func(pattern string) bool {
+		_, ok := config.Patterns[pattern]
+		return ok
+	})
+	if err := w.manager.applyLevelsConfig(config, false, removed); err != nil {
+		return fmt.Errorf("failed to load log levels from file %s: %w", w.path, err)
+	}
+
+	w.content = content
+	w.patterns = w.patterns[:0]
+	for pattern := range config.Patterns {
+		w.patterns = append(w.patterns, pattern)
+	}
+	if w.options.onReload != nil {
+		w.options.onReload()
+	}
+	return nil
+}
+
+func readLevelsFile(path string) (logLevelsConfig, []byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return logLevelsConfig{}, nil, fmt.Errorf("failed to read log levels file: %w", err)
+	}
+
+	var config logLevelsConfig
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&config)
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&config)
+		if errors.Is(err, io.EOF) {
+			// empty file
+			err = nil
+		}
+	default:
+		return logLevelsConfig{}, nil, fmt.Errorf("unsupported log levels file extension %q, expected .json, .yaml or .yml", ext)
+	}
+	if err != nil {
+		return logLevelsConfig{}, nil, fmt.Errorf("failed to parse log levels file %s: %w", path, err)
+	}
+	return config, content, nil
+}
@@ -0,0 +1,53 @@
+package slogx_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleLoadLevelsFromEnv() {
+	manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+
+	err := slogx.LoadLevelsFromEnv(manager, slogx.WithEnviron(func() []string {
+		return []string{"LOG_LEVEL=warn", "LOG_LEVEL_Orders_Repository=debug", "HOME=/root"}
+	}))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(manager.DefaultLevel(), manager.ServicePatternLevels())
+
+	// Output:
+	// WARN map[Orders.Repository:DEBUG]
+}
+
+func ExampleLoadLevelsFromFile() {
+	dir, err := os.MkdirTemp("", "levels")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "log-levels.yaml")
+	content := "level: error\npatterns:\n  Orders: debug\n  Payments.Client: warn\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+	if err := slogx.LoadLevelsFromFile(manager, path); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(manager.DefaultLevel(), manager.ServicePatternLevels())
+
+	// Output:
+	// ERROR map[Orders:DEBUG Payments.Client:WARN]
+}
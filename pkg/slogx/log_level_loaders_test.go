@@ -0,0 +1,224 @@
+package slogx_test
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func writeLevelsFile(t *testing.T, path, content string) {
+	t.Helper()
+	// write to a temporary file and rename it, so the watcher never sees a partially written file
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, []byte(content), 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+}
+
+func TestLoadLevelsFromEnv(t *testing.T) {
+	t.Run("custom prefix and numeric levels", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		environ := func() []string {
+			return []string{"APP_LOG=-4", "APP_LOG_Orders=error", "LOG_LEVEL=debug", "APP_LOGGER=ignored"}
+		}
+
+		// when:
+		err := slogx.LoadLevelsFromEnv(manager, slogx.WithEnvPrefix("APP_LOG"), slogx.WithEnviron(environ))
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, slog.LevelDebug, manager.DefaultLevel())
+		assert.Equal(t, map[string]slog.Level{"Orders": slog.LevelError}, manager.ServicePatternLevels())
+	})
+
+	t.Run("invalid variables are skipped and reported", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		environ := func() []string {
+			return []string{"LOG_LEVEL=debug", "LOG_LEVEL_Orders=verbose", "LOG_LEVEL_FORMAT=json", "LOG_LEVEL_Billing=warn"}
+		}
+
+		// when:
+		err := slogx.LoadLevelsFromEnv(manager, slogx.WithEnviron(environ))
+
+		// then:
+		require.ErrorContains(t, err, "skipped variable LOG_LEVEL_Orders")
+		require.ErrorContains(t, err, "skipped variable LOG_LEVEL_FORMAT")
+		assert.Equal(t, slog.LevelDebug, manager.DefaultLevel())
+		assert.Equal(t, map[string]slog.Level{"Billing": slog.LevelWarn}, manager.ServicePatternLevels())
+	})
+
+	t.Run("empty values are ignored", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelWarn)
+		environ := func() []string {
+			return []string{"LOG_LEVEL=", "LOG_LEVEL_Orders= ", "LOG_LEVEL_Billing=error"}
+		}
+
+		// when:
+		err := slogx.LoadLevelsFromEnv(manager, slogx.WithEnviron(environ))
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, slog.LevelWarn, manager.DefaultLevel())
+		assert.Equal(t, map[string]slog.Level{"Billing": slog.LevelError}, manager.ServicePatternLevels())
+	})
+
+	t.Run("double underscore stands for underscore in the pattern", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		environ := func() []string {
+			return []string{"LOG_LEVEL_order__service_Repository=debug"}
+		}
+
+		// when:
+		err := slogx.LoadLevelsFromEnv(manager, slogx.WithEnviron(environ))
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, map[string]slog.Level{"order_service.Repository": slog.LevelDebug}, manager.ServicePatternLevels())
+	})
+}
+
+func TestLoadLevelsFromFile(t *testing.T) {
+	t.Run("json file", func(t *testing.T) {
+		// given:
+		path := filepath.Join(t.TempDir(), "levels.json")
+		writeLevelsFile(t, path, `{"level": "warn", "patterns": {"A.B": "debug", "C": 8}}`)
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+
+		// when:
+		err := slogx.LoadLevelsFromFile(manager, path)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, slog.LevelWarn, manager.DefaultLevel())
+		assert.Equal(t, map[string]slog.Level{"A.B": slog.LevelDebug, "C": slog.LevelError}, manager.ServicePatternLevels())
+	})
+
+	t.Run("file without default level keeps it and existing patterns", func(t *testing.T) {
+		// given:
+		path := filepath.Join(t.TempDir(), "levels.yml")
+		writeLevelsFile(t, path, "patterns:\n  A: debug\n")
+		manager := slogx.NewLogLevelManager(slogx.LogLevelWarn)
+		require.NoError(t, manager.SetLevelForServicePattern("B", slog.LevelError))
+
+		// when:
+		err := slogx.LoadLevelsFromFile(manager, path)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, slog.LevelWarn, manager.DefaultLevel())
+		assert.Equal(t, map[string]slog.Level{"A": slog.LevelDebug, "B": slog.LevelError}, manager.ServicePatternLevels())
+	})
+
+	invalidFiles := map[string]struct {
+		name    string
+		content string
+		errMsg  string
+	}{
+		"unsupported extension": {name: "levels.toml", content: `level = "info"`, errMsg: "unsupported log levels file extension"},
+		"unknown field":         {name: "levels.yaml", content: "levels: info\n", errMsg: "not found"},
+		"malformed json":        {name: "levels.json", content: `{"level":`, errMsg: "failed to parse"},
+		"invalid level":         {name: "levels.yaml", content: "level: verbose\n", errMsg: "invalid default level"},
+		"missing pattern level": {name: "levels.yaml", content: "patterns:\n  A:\n", errMsg: "level for pattern A is required"},
+	}
+	for name, test := range invalidFiles {
+		t.Run("rejects "+name, func(t *testing.T) {
+			// given:
+			path := filepath.Join(t.TempDir(), test.name)
+			writeLevelsFile(t, path, test.content)
+			manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+
+			// when:
+			err := slogx.LoadLevelsFromFile(manager, path)
+
+			// then:
+			require.ErrorContains(t, err, test.errMsg)
+			assert.Equal(t, slog.LevelInfo, manager.DefaultLevel())
+			assert.Empty(t, manager.ServicePatternLevels())
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		err := slogx.LoadLevelsFromFile(slogx.NewLogLevelManager(slogx.LogLevelInfo), filepath.Join(t.TempDir(), "missing.yaml"))
+
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestWatchLevelsFile(t *testing.T) {
+	// given:
+	path := filepath.Join(t.TempDir(), "levels.yaml")
+	writeLevelsFile(t, path, "level: info\npatterns:\n  A: debug\n  B: warn\n")
+
+	manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+	require.NoError(t, manager.SetLevelForServicePattern("FromCode", slog.LevelError))
+
+	var reloads, errs atomic.Int32
+	err := slogx.WatchLevelsFile(t.Context(), manager, path,
+		slogx.WithLevelsFileWatchInterval(5*time.Millisecond),
+		slogx.WithLevelsFileReloadCallback(func() { reloads.Add(1) }),
+		slogx.WithLevelsFileWatchErrorHandler(func(error) { errs.Add(1) }),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), reloads.Load())
+	assert.Equal(t, map[string]slog.Level{"A": slog.LevelDebug, "B": slog.LevelWarn, "FromCode": slog.LevelError}, manager.ServicePatternLevels())
+
+	// when:
+	writeLevelsFile(t, path, "level: error\npatterns:\n  B: debug\n")
+
+	// then:
+	require.Eventually(t, func() bool { return reloads.Load() == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, slog.LevelError, manager.DefaultLevel())
+	assert.Equal(t, map[string]slog.Level{"B": slog.LevelDebug, "FromCode": slog.LevelError}, manager.ServicePatternLevels())
+
+	// when: the file becomes invalid
+	writeLevelsFile(t, path, "level: verbose\n")
+
+	// then: the previous levels are kept
+	require.Eventually(t, func() bool { return errs.Load() > 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, slog.LevelError, manager.DefaultLevel())
+	assert.Equal(t, map[string]slog.Level{"B": slog.LevelDebug, "FromCode": slog.LevelError}, manager.ServicePatternLevels())
+
+	// when: the file is fixed
+	writeLevelsFile(t, path, "patterns:\n  C: warn\n")
+
+	// then:
+	require.Eventually(t, func() bool { return reloads.Load() == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, map[string]slog.Level{"C": slog.LevelWarn, "FromCode": slog.LevelError}, manager.ServicePatternLevels())
+}
+
+func TestWatchLevelsFileFailsOnInvalidInitialFile(t *testing.T) {
+	// given:
+	path := filepath.Join(t.TempDir(), "levels.json")
+	writeLevelsFile(t, path, `{"level": "verbose"}`)
+
+	// when:
+	err := slogx.WatchLevelsFile(t.Context(), slogx.NewLogLevelManager(slogx.LogLevelInfo), path)
+
+	// then:
+	require.ErrorContains(t, err, "invalid default level")
+}
+
+func TestWatchLevelsFileFailsOnNonPositiveInterval(t *testing.T) {
+	// given:
+	path := filepath.Join(t.TempDir(), "levels.json")
+	writeLevelsFile(t, path, `{"level": "debug"}`)
+	manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+
+	// when:
+	err := slogx.WatchLevelsFile(t.Context(), manager, path, slogx.WithLevelsFileWatchInterval(0))
+
+	// then:
+	require.ErrorContains(t, err, "watch interval must be positive")
+	assert.Equal(t, slog.LevelInfo, manager.DefaultLevel())
+}
@@ -5,14 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 
-	"github.com/go-softwarelab/common/pkg/is"
-	"github.com/go-softwarelab/common/pkg/seq"
 	"github.com/go-softwarelab/common/pkg/seq2"
-	slicesx "github.com/go-softwarelab/common/pkg/slices"
 	"github.com/go-softwarelab/common/pkg/to"
 )
 
@@ -20,7 +19,9 @@ var _ slog.Handler = (*managedLogLevelHandler)(nil)
 
 //nolint:revive
 type DynamicLogLevelOptions struct {
-	serviceAttrKeys []string
+	serviceAttrKeys   []string
+	clock             LogLevelClock
+	onOverrideExpired func(LevelOverride)
 }
 
 // WithAdditionalDotPatternAttrKeys adds additional keys to the list of keys used for dynamic log level matching.
@@ -33,21 +34,26 @@ func WithAdditionalDotPatternAttrKeys(keys ...string) func(*DynamicLogLevelOptio
 }
 
 // LogLevelManager is a logger decorator that allows to configure log levels dynamically based on logger attributes.
+// It's safe to change the levels concurrently with logging.
 type LogLevelManager struct {
-	version      atomic.Uint64
-	defaultLevel slog.LevelVar
-	dotMatchers  []*dotPatternMatcher
-	options      DynamicLogLevelOptions
+	version         atomic.Uint64
+	defaultLevel    slog.LevelVar
+	mu              sync.RWMutex
+	patternMatchers []*patternMatcher
+	overrides       map[string]*levelOverride
+	options         DynamicLogLevelOptions
 }
 
 // NewLogLevelManager creates a new LogLevelManager.
 func NewLogLevelManager[L slog.Level | LogLevel](level L, opts ...func(*DynamicLogLevelOptions)) *LogLevelManager {
 	options := to.OptionsWithDefault(DynamicLogLevelOptions{
 		serviceAttrKeys: []string{ServiceKey, ComponentKey},
+		clock:           systemLogLevelClock{},
 	}, opts...)
 
 	logLevel := &LogLevelManager{
-		options: options,
+		options:   options,
+		overrides: make(map[string]*levelOverride),
 	}
 
 	switch l := any(level).(type) {
@@ -63,15 +69,44 @@ func NewLogLevelManager[L slog.Level | LogLevel](level L, opts ...func(*DynamicL
 }
 
 // SetLogLevel sets the default logging level to the specified slogx.LogLevel.
+// It cancels the time-limited override of the default level, if there is any.
 func (m *LogLevelManager) SetLogLevel(level LogLevel) {
-	m.defaultLevel.Set(level.MustGetSlogLevel())
+	m.SetLevel(level.MustGetSlogLevel())
 }
 
 // SetLevel sets the default logging level to the specified slog.Level.
+// It cancels the time-limited override of the default level, if there is any.
 func (m *LogLevelManager) SetLevel(level slog.Level) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.cancelOverrideLocked(defaultLevelOverrideKey)
 	m.defaultLevel.Set(level)
 }
 
+// DefaultLevel returns the default logging level, used for loggers not matching any pattern.
+func (m *LogLevelManager) DefaultLevel() slog.Level {
+	return m.defaultLevel.Level()
+}
+
+// ServicePatternLevels returns all the patterns with their levels.
+func (m *LogLevelManager) ServicePatternLevels() map[string]slog.Level {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	levels := make(map[string]slog.Level, len(m.patternMatchers))
+	for _, matcher := range m.patternMatchers {
+		levels[matcher.pattern] = matcher.level.Level()
+	}
+	return levels
+}
+
+// RemoveServicePattern removes the pattern, so the loggers matching it fall back to the other patterns or the default level.
+// Returns false if there was no such pattern.
+func (m *LogLevelManager) RemoveServicePattern(pattern string) bool {
+	return m.updateMatchers(false, nil, []string{pattern}) > 0
+}
+
 // SetLevelForServicePattern associates a logging level with a given simple dot-separated pattern for dynamic log level matching.
 // Returns an error if the pattern cannot be parsed or the level cannot be set.
 //
@@ -79,14 +114,33 @@ func (m *LogLevelManager) SetLevel(level slog.Level) {
 // The pattern's dot-separated parts are matched against the logger attributes values whose key is "service" (or "component").
 // The specified level is applied to all attributes that match the pattern.
 // The most specific matching pattern determines the log level.
-// If multiple patterns of equal specificity match, one is chosen arbitrarily.
+// If multiple patterns of equal specificity match, the one with record conditions ("message:" or "source:") is chosen,
+// then the one with fewer wildcard parts, and if it's still a tie, the first one in lexicographical order.
+// Setting the level for already existing pattern replaces its level and cancels its time-limited override, if there is any.
 //
 // For example:
 // Given the patterns: "Service1" and "Service1.Service2",
 // and logger attributes: service="Service1", service="Service2", user=1
 // the level set for the pattern "Service1.Service2" will be used.
+//
+// Wildcards: when any part of the dot pattern is a wildcard, the pattern must match the whole chain of the logger services, in order.
+// The "*" part matches exactly one service, "**" matches any number (also zero) of services,
+// and the parts like "Order*" are matched with path.Match rules.
+// For example "Service.*" matches the loggers of the services directly under "Service",
+// and "**.Repository" matches the loggers whose last service is "Repository".
+//
+// Conditions: the pattern can consist of comma-separated conditions, all of which must match:
+//   - a dot pattern (at most one), as described above,
+//   - attribute conditions like "tenant=acme" or "userId=42", matching the logger attributes with the given key and value,
+//   - "message:" followed by the prefix of the record message, like "message:cache miss",
+//   - "source:" followed by the glob of the source file path ending, like "source:orders/*.go".
+//
+// For example "Orders.Repository,tenant=acme" or "tenant=acme,message:slow query".
+// Each condition makes the pattern more specific (with the wildcard dot pattern parts less specific than the plain ones).
+// The message and source conditions depend on the record, so they are checked when the record is handled,
+// while the level for the logger attributes is calculated once and cached in the logger handler.
 func (m *LogLevelManager) SetLevelForServicePattern(pattern string, level slog.Level) error {
-	matcher, err := dotPatternMatcherFromString(pattern, level)
+	matcher, err := patternMatcherFromString(pattern, level)
 	if err != nil {
 		return fmt.Errorf("failed to set level for pattern: %w", err)
 	}
@@ -96,36 +150,44 @@ func (m *LogLevelManager) SetLevelForServicePattern(pattern string, level slog.L
 }
 
 // SetLevels updates logging levels using a map of patterns and their corresponding levels; returns an error if invalid input.
-// Currently only a dot-service-pattern is supported, see SetLevelForServicePattern for details.
+// See SetLevelForServicePattern for details about the patterns.
 // Value can be:
-//   - string - string value parseable to slogx.LogLevel
+//   - string - string value parseable to slogx.LogLevel, or to slog.Level (like "info+2")
 //   - int: any integer value - although it's recommended to use slog.Level values
+//   - float64 with integer value (as decoded from JSON)
 //   - slogx.LogLevel
 //   - slog.Level
 func (m *LogLevelManager) SetLevels(patterns map[string]any) error {
-	dotPatterns := seq2.Map(seq2.FromMap(patterns), func(pattern string, levelForPattern any) (*dotPatternMatcher, error) {
-		var level slog.Level
-		switch l := levelForPattern.(type) {
-		case string:
-			logLevel, err := ParseLogLevel(l)
-			if err != nil {
-				return nil, fmt.Errorf("invalid string level %q for pattern %s: %w", l, pattern, err)
-			}
-			level = logLevel.MustGetSlogLevel()
-		case int:
-			level = slog.Level(l)
-		case LogLevel:
-			level = l.MustGetSlogLevel()
-		case slog.Level:
-			level = l
-		default:
-			return nil, fmt.Errorf("unexpected type (%T) of level passed for pattern %s", levelForPattern, pattern)
+	matchers, err := matchersFromLevels(patterns)
+	if err != nil {
+		return err
+	}
+	m.addMatcher(matchers...)
+	return nil
+}
+
+// ReplaceLevels atomically replaces all the patterns with the given ones, the patterns missing in the map are removed.
+// Values are the same as in SetLevels. When any of the patterns or values is invalid, nothing is changed.
+func (m *LogLevelManager) ReplaceLevels(patterns map[string]any) error {
+	matchers, err := matchersFromLevels(patterns)
+	if err != nil {
+		return err
+	}
+	m.updateMatchers(true, matchers, nil)
+	return nil
+}
+
+func matchersFromLevels(patterns map[string]any) ([]*patternMatcher, error) {
+	dotPatterns := seq2.Map(seq2.FromMap(patterns), func(pattern string, levelForPattern any) (*patternMatcher, error) {
+		level, err := levelFromAny(levelForPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid level for pattern %s: %w", pattern, err)
 		}
-		return dotPatternMatcherFromString(pattern, level)
+		return patternMatcherFromString(pattern, level)
 	})
 
 	var err error
-	var matchers []*dotPatternMatcher
+	var matchers []*patternMatcher
 	for matcher, errCreateMatcher := range dotPatterns {
 		if errCreateMatcher != nil {
 			err = errors.Join(err, errCreateMatcher)
@@ -136,10 +198,9 @@ func (m *LogLevelManager) SetLevels(patterns map[string]any) error {
 		}
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
-	m.addMatcher(matchers...)
-	return nil
+	return matchers, nil
 }
 
 // Decorate decorates the given handler with the LogLevelManager.
@@ -152,122 +213,208 @@ func (m *LogLevelManager) DecorateHandler(handler slog.Handler, _ *DecoratorOpti
 	return newManagedLogLevelHandler(handler, m)
 }
 
-func (m *LogLevelManager) addMatcher(matchers ...*dotPatternMatcher) {
-	m.dotMatchers = append(m.dotMatchers, matchers...)
-	slices.SortFunc(m.dotMatchers, func(i, j *dotPatternMatcher) int {
-		return j.Priority() - i.Priority()
-	})
-	m.version.Add(1)
+func (m *LogLevelManager) addMatcher(matchers ...*patternMatcher) {
+	m.updateMatchers(false, matchers, nil)
 }
 
-func (m *LogLevelManager) getVersion() uint64 {
-	return m.version.Load()
+// updateMatchers sets the given matchers and removes the matchers of the given patterns, as a single change.
+// If replaceAll is true, all the matchers not being set are removed.
+// Returns the number of removed matchers.
+func (m *LogLevelManager) updateMatchers(replaceAll bool, matchers []*patternMatcher, removePatterns []string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.updateMatchersLocked(replaceAll, matchers, removePatterns)
 }
 
-func (m *LogLevelManager) calculateLevel(attrs []slog.Attr) *slog.LevelVar {
-	// optimization for dot matchers
-	serviceOnlyAttrs := slicesx.Filter(attrs, func(attr slog.Attr) bool {
-		return slices.Contains(m.options.serviceAttrKeys, attr.Key)
+// updateMatchersLocked is the same as updateMatchers, but it requires the caller to hold the lock.
+// The overrides of the changed and removed patterns are canceled.
+func (m *LogLevelManager) updateMatchersLocked(replaceAll bool, matchers []*patternMatcher, removePatterns []string) int {
+	countBefore := len(m.patternMatchers)
+	m.patternMatchers = slices.DeleteFunc(m.patternMatchers, func(existing *patternMatcher) bool {
+		if replaceAll {
+			return !slices.ContainsFunc(matchers, func(matcher *patternMatcher) bool {
+				return matcher.pattern == existing.pattern
+			})
+		}
+		return slices.Contains(removePatterns, existing.pattern)
 	})
+	removed := countBefore - len(m.patternMatchers)
+
+	for _, matcher := range matchers {
+		i := slices.IndexFunc(m.patternMatchers, func(existing *patternMatcher) bool {
+			return existing.pattern == matcher.pattern
+		})
+		if i >= 0 {
+			// handlers may have cached the level of the existing matcher, so it is updated in place
+			m.patternMatchers[i].level.Set(matcher.level.Level())
+			continue
+		}
+		m.patternMatchers = append(m.patternMatchers, matcher)
+	}
+	slices.SortFunc(m.patternMatchers, compareMatchers)
 
-	for _, matcher := range m.dotMatchers {
-		if matcher.Match(serviceOnlyAttrs) {
-			return matcher.level
+	for pattern := range m.overrides {
+		if pattern == defaultLevelOverrideKey {
+			continue
+		}
+		changed := slices.ContainsFunc(matchers, func(matcher *patternMatcher) bool {
+			return matcher.pattern == pattern
+		})
+		if changed || !m.hasPatternLocked(pattern) {
+			m.cancelOverrideLocked(pattern)
 		}
 	}
 
-	return &m.defaultLevel
+	if len(matchers) > 0 || removed > 0 {
+		m.version.Add(1)
+	}
+	return removed
 }
 
-type dotPatternMatcher struct {
-	level    *slog.LevelVar
-	services []string
+func (m *LogLevelManager) hasPatternLocked(pattern string) bool {
+	return slices.ContainsFunc(m.patternMatchers, func(matcher *patternMatcher) bool {
+		return matcher.pattern == pattern
+	})
 }
 
-func dotPatternMatcherFromString(pattern string, level slog.Level) (*dotPatternMatcher, error) {
-	matcher, err := parseDotPattern(pattern)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse pattern %q: %w", pattern, err)
-	}
-	matcher.level.Set(level)
-	return matcher, nil
+func (m *LogLevelManager) getVersion() uint64 {
+	return m.version.Load()
 }
 
-// Match returns true if the matcher matches the given attributes.
-func (m *dotPatternMatcher) Match(attrs []slog.Attr) bool {
-	filtered := seq.Filter(seq.FromSlice(attrs), func(attr slog.Attr) bool {
-		// WARNING: there is prefiltering applied in calculateLevel,
-		// 	so currently we will skip filtering by attr key here
-		//  but if we would like to publish this struct, we should consider adding it,
-		//  or add type switch in calculateLevel before prefiltering
+// calculateLevel returns the level of the logger with the given attributes,
+// together with the patterns depending on the record which are more specific than the pattern of the level.
+func (m *LogLevelManager) calculateLevel(attrs []slog.Attr) cachedLevel {
+	// optimization for dot patterns
+	services := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		if attr.Value.Kind() == slog.KindString && slices.Contains(m.options.serviceAttrKeys, attr.Key) {
+			services = append(services, attr.Value.String())
+		}
+	}
 
-		return attr.Value.Kind() == slog.KindString
-	})
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-	values := seq.Map(filtered, func(attr slog.Attr) string {
-		return attr.Value.String()
-	})
+	var recordRules []*patternMatcher
+	for _, matcher := range m.patternMatchers {
+		if !matcher.matchesLogger(services, attrs) {
+			continue
+		}
+		if matcher.hasRecordConditions() {
+			recordRules = append(recordRules, matcher)
+			continue
+		}
+		return cachedLevel{level: matcher.level, recordRules: recordRules}
+	}
 
-	return seq.ContainsAll(values, m.services...)
+	return cachedLevel{level: &m.defaultLevel, recordRules: recordRules}
 }
 
-// Priority returns the matcher's priority.'
-func (m *dotPatternMatcher) Priority() int {
-	return len(m.services)
+// levelFromAny converts the level value accepted by SetLevels to slog.Level.
+// The strings are parsed like by slog.Level.UnmarshalText (so the levels between the standard ones, like "info+2", are accepted),
+// apart from "none" which is LevelNone.
+func levelFromAny(level any) (slog.Level, error) {
+	switch l := level.(type) {
+	case string:
+		if LogLevel(l) == LogLevelNone {
+			return LevelNone, nil
+		}
+		var slogLevel slog.Level
+		if err := slogLevel.UnmarshalText([]byte(l)); err != nil {
+			return 0, fmt.Errorf("invalid string level %q: %w", l, err)
+		}
+		return slogLevel, nil
+	case int:
+		return slog.Level(l), nil
+	case float64:
+		if l != math.Trunc(l) {
+			return 0, fmt.Errorf("level %v is not an integer", l)
+		}
+		return slog.Level(l), nil
+	case LogLevel:
+		return l.GetSlogLevel() //nolint:wrapcheck
+	case slog.Level:
+		return l, nil
+	default:
+		return 0, fmt.Errorf("unexpected type (%T) of level", level)
+	}
 }
 
-func parseDotPattern(pattern string) (*dotPatternMatcher, error) {
-	if is.BlankString(pattern) {
-		return nil, fmt.Errorf("pattern must not be empty")
+// levelName returns the name of the level, which is accepted back by levelFromAny, also for the levels between the standard ones.
+func levelName(level slog.Level) string {
+	if level == LevelNone {
+		return string(LogLevelNone)
 	}
-
-	services := strings.Split(pattern, ".")
-
-	return &dotPatternMatcher{
-		services: services,
-		level:    &slog.LevelVar{},
-	}, nil
+	return strings.ToLower(level.String())
 }
 
 type managedLogLevelHandler struct {
 	levelCalculator *LogLevelManager
-	levelVersion    uint64
 	h               slog.Handler
-	level           *slog.LevelVar
-	attrs           []slog.Attr
+	// cached is the level calculated for the attrs, it's replaced when the manager version changes
+	cached atomic.Pointer[cachedLevel]
+	attrs  []slog.Attr
+}
+
+type cachedLevel struct {
+	version uint64
+	level   *slog.LevelVar
+	// recordRules are the matching patterns depending on the record, sorted by priority, which take precedence over the level
+	recordRules []*patternMatcher
 }
 
 func newManagedLogLevelHandler(wrappedHandler slog.Handler, level *LogLevelManager) *managedLogLevelHandler {
-	return &managedLogLevelHandler{
+	handler := &managedLogLevelHandler{
 		h:               wrappedHandler,
 		levelCalculator: level,
-		level:           &level.defaultLevel,
 	}
+	handler.cached.Store(&cachedLevel{level: &level.defaultLevel})
+	return handler
 }
 
 // Enabled returns true if the log level is enabled.
+// When there are patterns depending on the record, it returns true if any of them can enable the level,
+// and the final decision is made in Handle.
 func (h *managedLogLevelHandler) Enabled(_ context.Context, l slog.Level) bool {
-	if h.levelVersion < h.levelCalculator.getVersion() {
-		h.levelVersion = h.levelCalculator.getVersion()
-		h.level = h.levelCalculator.calculateLevel(h.attrs)
+	cached := h.currentLevel()
+	if l >= cached.level.Level() {
+		return true
+	}
+	for _, rule := range cached.recordRules {
+		if l >= rule.level.Level() {
+			return true
+		}
 	}
-	return l >= h.level.Level()
+	return false
+}
+
+func (h *managedLogLevelHandler) currentLevel() *cachedLevel {
+	cached := h.cached.Load()
+	if version := h.levelCalculator.getVersion(); cached.version < version {
+		calculated := h.levelCalculator.calculateLevel(h.attrs)
+		calculated.version = version
+		cached = &calculated
+		h.cached.Store(cached)
+	}
+	return cached
 }
 
 // WithAttrs returns a new handler with the given attributes.
 func (h *managedLogLevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	wh := h.h.WithAttrs(attrs)
-	attrs = append(h.attrs[:], attrs...)
+	attrs = append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...)
 	levelVersion := h.levelCalculator.getVersion()
-	level := h.levelCalculator.calculateLevel(attrs)
+	calculated := h.levelCalculator.calculateLevel(attrs)
+	calculated.version = levelVersion
 
-	return &managedLogLevelHandler{
+	handler := &managedLogLevelHandler{
 		h:               wh,
 		attrs:           attrs,
 		levelCalculator: h.levelCalculator,
-		level:           level,
-		levelVersion:    levelVersion,
 	}
+	handler.cached.Store(&calculated)
+	return handler
 }
 
 // WithGroup returns a new handler with the given group name.
@@ -275,7 +422,27 @@ func (h *managedLogLevelHandler) WithGroup(name string) slog.Handler {
 	return newManagedLogLevelHandler(h.h.WithGroup(name), h.levelCalculator)
 }
 
-// Handle handles the log record.
+// Handle handles the log record, dropping it if it's disabled by the patterns depending on the record.
 func (h *managedLogLevelHandler) Handle(ctx context.Context, record slog.Record) error {
+	if cached := h.currentLevel(); len(cached.recordRules) > 0 {
+		level := cached.level
+		var file *string
+		sourceFile := func() string {
+			if file == nil {
+				f := recordSourceFile(&record)
+				file = &f
+			}
+			return *file
+		}
+		for _, rule := range cached.recordRules {
+			if rule.matchesRecord(&record, sourceFile) {
+				level = rule.level
+				break
+			}
+		}
+		if record.Level < level.Level() {
+			return nil
+		}
+	}
 	return h.h.Handle(ctx, record) //nolint:wrapcheck
 }
@@ -2,7 +2,10 @@ package slogx_test
 
 import (
 	"log/slog"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-softwarelab/common/pkg/slogx"
 	"github.com/go-softwarelab/common/pkg/to"
@@ -468,3 +471,549 @@ func assertLevelEnabled(t *testing.T, handler slog.Handler, level slog.Level) {
 		}
 	}
 }
+
+func TestLogLevelManagerPatternsManagement(t *testing.T) {
+	t.Run("setting existing pattern replaces its level in already created handlers", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		require.NoError(t, manager.SetLevelForServicePattern("A", slog.LevelDebug))
+		handler := manager.Decorate(slog.DiscardHandler).WithAttrs([]slog.Attr{slogx.Service("A")})
+		require.True(t, handler.Enabled(t.Context(), slog.LevelDebug))
+
+		// when:
+		require.NoError(t, manager.SetLevelForServicePattern("A", slog.LevelError))
+
+		// then:
+		assert.False(t, handler.Enabled(t.Context(), slog.LevelWarn))
+		assert.Equal(t, map[string]slog.Level{"A": slog.LevelError}, manager.ServicePatternLevels())
+	})
+
+	t.Run("removed pattern no longer applies", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		require.NoError(t, manager.SetLevels(map[string]any{"A": "error", "A.B": "debug"}))
+		handler := manager.Decorate(slog.DiscardHandler).WithAttrs([]slog.Attr{slogx.Service("A"), slogx.Service("B")})
+		require.True(t, handler.Enabled(t.Context(), slog.LevelDebug))
+
+		// when:
+		removed := manager.RemoveServicePattern("A.B")
+
+		// then:
+		assert.True(t, removed)
+		assert.False(t, manager.RemoveServicePattern("A.B"))
+		assert.False(t, handler.Enabled(t.Context(), slog.LevelWarn))
+	})
+
+	t.Run("replace levels removes missing patterns", func(t *testing.T) {
+		// given:
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		require.NoError(t, manager.SetLevels(map[string]any{"A": "error", "B": "debug"}))
+
+		// when:
+		err := manager.ReplaceLevels(map[string]any{"B": "warn", "C": "debug"})
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, map[string]slog.Level{"B": slog.LevelWarn, "C": slog.LevelDebug}, manager.ServicePatternLevels())
+	})
+}
+
+// manualClock is a LogLevelClock whose time moves only with Advance, which calls the due timers in order of their time.
+type manualClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*manualTimer
+}
+
+type manualTimer struct {
+	at time.Time
+	f  func()
+}
+
+func newManualClock(now time.Time) *manualClock {
+	return &manualClock{now: now}
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *manualClock) AfterFunc(d time.Duration, f func()) func() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &manualTimer{at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, timer)
+	return func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		i := slices.Index(c.timers, timer)
+		if i < 0 {
+			return false
+		}
+		c.timers = slices.Delete(c.timers, i, i+1)
+		return true
+	}
+}
+
+// Advance moves the time forward by d, calling the due timers in the calling goroutine.
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	end := c.now.Add(d)
+	for {
+		due := -1
+		for i, timer := range c.timers {
+			if !timer.at.After(end) && (due < 0 || timer.at.Before(c.timers[due].at)) {
+				due = i
+			}
+		}
+		if due < 0 {
+			c.now = end
+			return
+		}
+
+		timer := c.timers[due]
+		c.timers = slices.Delete(c.timers, due, due+1)
+		c.now = timer.at
+
+		// the timer function acquires the lock of the manager, which is held while the timers are started or stopped
+		c.mu.Unlock()
+		timer.f()
+		c.mu.Lock()
+	}
+}
+
+func TestLogLevelManagerOverrides(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newManager := func() (*slogx.LogLevelManager, *manualClock, *[]slogx.LevelOverride) {
+		clock := newManualClock(now)
+		expired := &[]slogx.LevelOverride{}
+		manager := slogx.NewLogLevelManager(
+			slogx.LogLevelInfo,
+			slogx.WithLogLevelClock(clock),
+			slogx.WithLevelOverrideExpiredCallback(func(override slogx.LevelOverride) {
+				*expired = append(*expired, override)
+			}),
+		)
+		return manager, clock, expired
+	}
+
+	t.Run("default level override is reverted after duration without logging", func(t *testing.T) {
+		// given:
+		manager, clock, expired := newManager()
+		handler := manager.Decorate(slog.DiscardHandler)
+
+		// when:
+		require.NoError(t, manager.SetLevelFor(slog.LevelDebug, time.Minute))
+		clock.Advance(time.Minute - time.Nanosecond)
+
+		// then:
+		assert.True(t, handler.Enabled(t.Context(), slog.LevelDebug))
+		assert.Empty(t, *expired)
+
+		// when:
+		clock.Advance(time.Nanosecond)
+
+		// then:
+		assert.Equal(t, []slogx.LevelOverride{{Pattern: "", Level: slog.LevelDebug, ExpiresAt: now.Add(time.Minute)}}, *expired)
+		assert.False(t, handler.Enabled(t.Context(), slog.LevelDebug))
+		assert.Equal(t, slog.LevelInfo, manager.DefaultLevel())
+		assert.Empty(t, manager.ActiveOverrides())
+	})
+
+	t.Run("new pattern is removed after duration", func(t *testing.T) {
+		// given:
+		manager, clock, expired := newManager()
+		handler := manager.Decorate(slog.DiscardHandler).WithAttrs([]slog.Attr{slogx.Service("A")})
+
+		// when:
+		require.NoError(t, manager.SetLevelForServicePatternFor("A", slog.LevelDebug, time.Minute))
+
+		// then:
+		assert.True(t, handler.Enabled(t.Context(), slog.LevelDebug))
+
+		// when:
+		clock.Advance(time.Minute)
+
+		// then:
+		assert.Len(t, *expired, 1)
+		assert.False(t, handler.Enabled(t.Context(), slog.LevelDebug))
+		assert.Empty(t, manager.ServicePatternLevels())
+	})
+
+	t.Run("existing pattern level is restored after duration", func(t *testing.T) {
+		// given:
+		manager, clock, expired := newManager()
+		require.NoError(t, manager.SetLevelForServicePattern("A", slog.LevelWarn))
+
+		// when:
+		require.NoError(t, manager.SetLevelForServicePatternFor("A", slog.LevelDebug, time.Hour))
+		require.NoError(t, manager.SetLevelForServicePatternFor("A", slog.LevelError, time.Minute))
+
+		// then:
+		assert.Equal(t, map[string]slog.Level{"A": slog.LevelError}, manager.ServicePatternLevels())
+		assert.Equal(t, []slogx.LevelOverride{{Pattern: "A", Level: slog.LevelError, ExpiresAt: now.Add(time.Minute)}}, manager.ActiveOverrides())
+
+		// when:
+		clock.Advance(time.Minute)
+
+		// then:
+		assert.Len(t, *expired, 1)
+		assert.Equal(t, map[string]slog.Level{"A": slog.LevelWarn}, manager.ServicePatternLevels())
+	})
+
+	t.Run("replaced override is not reverted after its duration", func(t *testing.T) {
+		// given:
+		manager, clock, expired := newManager()
+		require.NoError(t, manager.SetLevelForServicePatternFor("A", slog.LevelDebug, time.Minute))
+
+		// when:
+		require.NoError(t, manager.SetLevelForServicePatternFor("A", slog.LevelError, time.Hour))
+		clock.Advance(time.Hour - time.Nanosecond)
+
+		// then:
+		assert.Empty(t, *expired)
+		assert.Equal(t, map[string]slog.Level{"A": slog.LevelError}, manager.ServicePatternLevels())
+		assert.Equal(t, []slogx.LevelOverride{{Pattern: "A", Level: slog.LevelError, ExpiresAt: now.Add(time.Hour)}}, manager.ActiveOverrides())
+	})
+
+	t.Run("permanent change cancels override", func(t *testing.T) {
+		// given:
+		manager, clock, expired := newManager()
+		require.NoError(t, manager.SetLevelFor(slog.LevelDebug, time.Minute))
+		require.NoError(t, manager.SetLevelForServicePatternFor("A", slog.LevelDebug, time.Minute))
+		require.NoError(t, manager.SetLevelForServicePatternFor("B", slog.LevelDebug, time.Minute))
+
+		// when:
+		manager.SetLevel(slog.LevelWarn)
+		require.NoError(t, manager.SetLevelForServicePattern("A", slog.LevelError))
+		manager.RemoveServicePattern("B")
+		clock.Advance(time.Hour)
+
+		// then:
+		assert.Empty(t, *expired)
+		assert.Empty(t, manager.ActiveOverrides())
+		assert.Equal(t, slog.LevelWarn, manager.DefaultLevel())
+		assert.Equal(t, map[string]slog.Level{"A": slog.LevelError}, manager.ServicePatternLevels())
+	})
+
+	t.Run("callback is called for expired overrides in expiry order", func(t *testing.T) {
+		// given:
+		manager, clock, expired := newManager()
+		require.NoError(t, manager.SetLevelForServicePatternFor("A", slog.LevelDebug, 3*time.Minute))
+		require.NoError(t, manager.SetLevelFor(slog.LevelDebug, time.Minute))
+		require.NoError(t, manager.SetLevelForServicePatternFor("B", slog.LevelDebug, time.Hour))
+
+		// when:
+		clock.Advance(3 * time.Minute)
+
+		// then:
+		assert.Equal(t, []slogx.LevelOverride{
+			{Pattern: "", Level: slog.LevelDebug, ExpiresAt: now.Add(time.Minute)},
+			{Pattern: "A", Level: slog.LevelDebug, ExpiresAt: now.Add(3 * time.Minute)},
+		}, *expired)
+		assert.Equal(t, []slogx.LevelOverride{{Pattern: "B", Level: slog.LevelDebug, ExpiresAt: now.Add(time.Hour)}}, manager.ActiveOverrides())
+	})
+
+	t.Run("override is reverted with system clock", func(t *testing.T) {
+		// given:
+		expired := make(chan slogx.LevelOverride, 1)
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo, slogx.WithLevelOverrideExpiredCallback(func(override slogx.LevelOverride) {
+			expired <- override
+		}))
+
+		// when:
+		require.NoError(t, manager.SetLevelFor(slog.LevelDebug, time.Millisecond))
+
+		// then:
+		select {
+		case override := <-expired:
+			assert.Equal(t, slog.LevelDebug, override.Level)
+		case <-time.After(time.Second):
+			t.Fatal("override hasn't expired")
+		}
+		assert.Equal(t, slog.LevelInfo, manager.DefaultLevel())
+	})
+
+	t.Run("rejects non positive duration", func(t *testing.T) {
+		manager, _, _ := newManager()
+
+		require.Error(t, manager.SetLevelFor(slog.LevelDebug, 0))
+		require.Error(t, manager.SetLevelForServicePatternFor("A", slog.LevelDebug, -time.Second))
+		assert.Empty(t, manager.ActiveOverrides())
+	})
+}
+
+func TestDynamicLogLevelHandlerRichPatterns(t *testing.T) {
+	matchingTestCases := map[string]struct {
+		pattern          string
+		attrs            []slog.Attr
+		levelShouldApply bool
+	}{
+		"single wildcard matches direct child": {
+			pattern:          "Service.*",
+			attrs:            []slog.Attr{slogx.Service("Service"), slogx.Service("Child")},
+			levelShouldApply: true,
+		},
+		"single wildcard doesn't match service itself": {
+			pattern: "Service.*",
+			attrs:   []slog.Attr{slogx.Service("Service")},
+		},
+		"single wildcard doesn't match grandchild": {
+			pattern: "Service.*",
+			attrs:   []slog.Attr{slogx.Service("Service"), slogx.Service("Child"), slogx.Service("GrandChild")},
+		},
+		"wildcard pattern is anchored at the beginning of the chain": {
+			pattern: "Service.*",
+			attrs:   []slog.Attr{slogx.Service("App"), slogx.Service("Service"), slogx.Service("Child")},
+		},
+		"double wildcard matches any number of services before": {
+			pattern:          "**.Repository",
+			attrs:            []slog.Attr{slogx.Service("App"), slogx.Service("Orders"), slogx.Service("Repository")},
+			levelShouldApply: true,
+		},
+		"double wildcard matches zero services": {
+			pattern:          "**.Repository",
+			attrs:            []slog.Attr{slogx.Service("Repository")},
+			levelShouldApply: true,
+		},
+		"double wildcard pattern requires the last service": {
+			pattern: "**.Repository",
+			attrs:   []slog.Attr{slogx.Service("Repository"), slogx.Service("Cache")},
+		},
+		"double wildcard in the middle": {
+			pattern:          "App.**.Repository",
+			attrs:            []slog.Attr{slogx.Service("App"), slogx.Component("Orders"), slogx.Service("Repository")},
+			levelShouldApply: true,
+		},
+		"glob part": {
+			pattern:          "Order*.Repository",
+			attrs:            []slog.Attr{slogx.Service("OrdersService"), slogx.Service("Repository")},
+			levelShouldApply: true,
+		},
+		"attribute condition": {
+			pattern:          "tenant=acme",
+			attrs:            []slog.Attr{slogx.Service("Orders"), slog.String("tenant", "acme")},
+			levelShouldApply: true,
+		},
+		"attribute condition with non string value": {
+			pattern:          "userId=42",
+			attrs:            []slog.Attr{slogx.UserID(42)},
+			levelShouldApply: true,
+		},
+		"attribute condition with different value": {
+			pattern: "tenant=acme",
+			attrs:   []slog.Attr{slog.String("tenant", "other")},
+		},
+		"dot pattern combined with attribute conditions": {
+			pattern:          "Orders.Repository, tenant=acme, region=eu",
+			attrs:            []slog.Attr{slogx.Service("Orders"), slog.String("region", "eu"), slogx.Service("Repository"), slog.String("tenant", "acme")},
+			levelShouldApply: true,
+		},
+		"dot pattern combined with attribute condition requires both": {
+			pattern: "Orders,tenant=acme",
+			attrs:   []slog.Attr{slogx.Service("Orders"), slog.String("tenant", "other")},
+		},
+	}
+	for name, test := range matchingTestCases {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			loggerLevel := slogx.NewLogLevelManager(slogx.LogLevelNone)
+			require.NoError(t, loggerLevel.SetLevelForServicePattern(test.pattern, slog.LevelDebug), "invalid test setup: invalid pattern")
+
+			// when:
+			handler := loggerLevel.Decorate(slog.DiscardHandler).WithAttrs(test.attrs)
+
+			// then:
+			assert.Equal(t, test.levelShouldApply, handler.Enabled(t.Context(), slog.LevelDebug))
+		})
+	}
+
+	prioritizationTests := map[string]struct {
+		levels        map[string]any
+		attrs         []slog.Attr
+		expectedLevel slog.Level
+	}{
+		"plain part is more specific than wildcard": {
+			levels:        map[string]any{"Orders.*": "warn", "Orders.Repository": "debug"},
+			attrs:         []slog.Attr{slogx.Service("Orders"), slogx.Service("Repository")},
+			expectedLevel: slog.LevelDebug,
+		},
+		"wildcard is more specific than double wildcard": {
+			levels:        map[string]any{"**.Repository": "warn", "*.Repository": "error"},
+			attrs:         []slog.Attr{slogx.Service("Orders"), slogx.Service("Repository")},
+			expectedLevel: slog.LevelError,
+		},
+		"attribute condition makes pattern more specific": {
+			levels:        map[string]any{"Orders": "warn", "Orders,tenant=acme": "debug"},
+			attrs:         []slog.Attr{slogx.Service("Orders"), slog.String("tenant", "acme")},
+			expectedLevel: slog.LevelDebug,
+		},
+	}
+
+	tieTests := map[string]struct {
+		patterns      []string
+		attrs         []slog.Attr
+		expectedLevel slog.Level
+	}{
+		"literal pattern wins over double wildcard with the same priority": {
+			patterns:      []string{"**.Repository", "Repository"},
+			attrs:         []slog.Attr{slogx.Service("Repository")},
+			expectedLevel: slog.LevelDebug,
+		},
+		"patterns with the same specificity are ordered by pattern string": {
+			patterns:      []string{"Orders.*", "*.Repository"},
+			attrs:         []slog.Attr{slogx.Service("Orders"), slogx.Service("Repository")},
+			expectedLevel: slog.LevelDebug,
+		},
+	}
+	for name, test := range tieTests {
+		for _, patterns := range [][]string{test.patterns, {test.patterns[1], test.patterns[0]}} {
+			t.Run(name+" regardless of order", func(t *testing.T) {
+				// given:
+				loggerLevel := slogx.NewLogLevelManager(slogx.LogLevelNone)
+				levels := map[string]slog.Level{test.patterns[0]: slog.LevelWarn, test.patterns[1]: slog.LevelDebug}
+				for _, pattern := range patterns {
+					require.NoError(t, loggerLevel.SetLevelForServicePattern(pattern, levels[pattern]), "invalid test setup: invalid pattern")
+				}
+
+				// when:
+				handler := loggerLevel.Decorate(slog.DiscardHandler).WithAttrs(test.attrs)
+
+				// then:
+				assertLevelEnabled(t, handler, test.expectedLevel)
+			})
+		}
+	}
+	for name, test := range prioritizationTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			loggerLevel := slogx.NewLogLevelManager(slogx.LogLevelNone)
+			require.NoError(t, loggerLevel.SetLevels(test.levels), "invalid test setup: invalid patterns")
+
+			// when:
+			handler := loggerLevel.Decorate(slog.DiscardHandler).WithAttrs(test.attrs)
+
+			// then:
+			assertLevelEnabled(t, handler, test.expectedLevel)
+		})
+	}
+
+	invalidPatterns := []string{"", "  ", "A,,B", "A,B", "Or[der", "=value", "message:", "message:a,message:b", "source:", "source:[a"}
+	for _, pattern := range invalidPatterns {
+		t.Run("rejects invalid pattern "+pattern, func(t *testing.T) {
+			err := slogx.NewLogLevelManager(slogx.LogLevelInfo).SetLevelForServicePattern(pattern, slog.LevelDebug)
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDynamicLogLevelHandlerRecordPatterns(t *testing.T) {
+	newLogger := func(t *testing.T, levels map[string]any) (*slog.Logger, *slogx.CollectingLogsWriter) {
+		t.Helper()
+		manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+		require.NoError(t, manager.SetLevels(levels), "invalid test setup: invalid patterns")
+		output := slogx.NewCollectingLogsWriter()
+		logger := slogx.NewLogger(
+			slogx.WithWriter(output),
+			slogx.WithFormat(slogx.TextWithoutTimeFormat),
+			slogx.WithLevel(slogx.LogLevelDebug),
+			slogx.WithDecorator(manager),
+		)
+		return logger, output
+	}
+
+	t.Run("message prefix lowers the level", func(t *testing.T) {
+		// given:
+		logger, output := newLogger(t, map[string]any{"message:cache": "debug"})
+
+		// when:
+		logger.Debug("cache miss")
+		logger.Debug("query executed")
+
+		// then:
+		assert.Equal(t, "level=DEBUG msg=\"cache miss\"\n", output.String())
+	})
+
+	t.Run("message prefix raises the level", func(t *testing.T) {
+		// given:
+		logger, output := newLogger(t, map[string]any{"message:heartbeat": "error"})
+
+		// when:
+		logger.Info("heartbeat sent")
+		logger.Info("order created")
+
+		// then:
+		assert.Equal(t, "level=INFO msg=\"order created\"\n", output.String())
+	})
+
+	t.Run("message condition combined with dot pattern", func(t *testing.T) {
+		// given:
+		logger, output := newLogger(t, map[string]any{"Orders": "warn", "Orders,message:slow": "debug"})
+		orders := slogx.Child(logger, "Orders")
+
+		// when:
+		orders.Debug("slow query")
+		orders.Info("order created")
+		logger.Debug("slow query")
+
+		// then:
+		assert.Equal(t, "level=DEBUG msg=\"slow query\" service=Orders\n", output.String())
+	})
+
+	t.Run("source file condition", func(t *testing.T) {
+		// given:
+		logger, output := newLogger(t, map[string]any{"source:slogx/log_level_manager_*.go": "debug"})
+
+		// when:
+		logger.Debug("from test file")
+
+		// then:
+		assert.Equal(t, "level=DEBUG msg=\"from test file\"\n", output.String())
+	})
+
+	t.Run("not matching source file condition", func(t *testing.T) {
+		// given:
+		logger, output := newLogger(t, map[string]any{"source:orders/*.go": "debug"})
+
+		// when:
+		logger.Debug("from test file")
+
+		// then:
+		assert.Empty(t, output.String())
+	})
+
+	t.Run("less specific record pattern doesn't override logger pattern", func(t *testing.T) {
+		// given:
+		logger, output := newLogger(t, map[string]any{"Orders.Repository,tenant=acme": "error", "message:slow": "debug"})
+		repository := slogx.Child(slogx.Child(logger, "Orders"), "Repository").With("tenant", "acme")
+
+		// when:
+		repository.Debug("slow query")
+		repository.Warn("slow query")
+
+		// then:
+		assert.Empty(t, output.String())
+	})
+
+	t.Run("record pattern wins over logger pattern of the same priority regardless of service name", func(t *testing.T) {
+		// given:
+		logger, output := newLogger(t, map[string]any{"orders": "debug", "billing": "debug", "message:health": "error"})
+
+		// when:
+		for _, service := range []string{"orders", "billing"} {
+			child := slogx.Child(logger, service)
+			child.Info("health check ok")
+			child.Debug("query executed")
+		}
+
+		// then:
+		assert.Equal(t, "level=DEBUG msg=\"query executed\" service=orders\nlevel=DEBUG msg=\"query executed\" service=billing\n", output.String())
+	})
+}
@@ -0,0 +1,207 @@
+package slogx
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// defaultLevelOverrideKey is the key of the default level override, it can't collide with patterns as they mustn't be blank.
+const defaultLevelOverrideKey = ""
+
+// LevelOverride is a time-limited level set with LogLevelManager.SetLevelFor or LogLevelManager.SetLevelForServicePatternFor.
+type LevelOverride struct {
+	// Pattern is the dot-service-pattern of the override, it's empty for the override of the default level.
+	Pattern string
+	// Level is the level applied until the override expires.
+	Level slog.Level
+	// ExpiresAt is the time when the level is reverted.
+	ExpiresAt time.Time
+}
+
+type levelOverride struct {
+	LevelOverride
+	// previous is the level to restore after expiry, nil if the pattern didn't exist before the override
+	previous *slog.Level
+	// stopTimer stops the timer reverting the override after its duration, it's called when the override is replaced or canceled
+	stopTimer func() bool
+}
+
+// LogLevelClock is the source of time for the time-limited overrides of LogLevelManager.
+type LogLevelClock interface {
+	// Now returns the current time, used for the expiry time of the overrides.
+	Now() time.Time
+	// AfterFunc calls f after the duration elapses, like time.AfterFunc, and returns the function stopping the timer.
+	// The stop function returns false if f has been already called or the timer has been already stopped.
+	// Both AfterFunc and the stop function are called with the lock of LogLevelManager held, which f acquires,
+	// so f mustn't be called synchronously by any of them.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+// systemLogLevelClock is the LogLevelClock based on the system time and timers.
+type systemLogLevelClock struct{}
+
+func (systemLogLevelClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemLogLevelClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// WithLogLevelClock sets the clock used for the expiry time and for reverting the time-limited overrides,
+// by default the system time and timers are used.
+// It is useful for testing, a fake clock can revert the overrides deterministically, without waiting for their duration.
+func WithLogLevelClock(clock LogLevelClock) func(*DynamicLogLevelOptions) {
+	return func(options *DynamicLogLevelOptions) {
+		options.clock = clock
+	}
+}
+
+// WithLevelOverrideExpiredCallback sets the function called when the time-limited override expires and its level is reverted.
+// The callback is called in the goroutine of the timer which reverted the override (see LogLevelClock.AfterFunc), so it doesn't need any logging to happen,
+// but it mustn't block for long.
+func WithLevelOverrideExpiredCallback(onExpired func(LevelOverride)) func(*DynamicLogLevelOptions) {
+	return func(options *DynamicLogLevelOptions) {
+		options.onOverrideExpired = onExpired
+	}
+}
+
+// SetLevelFor sets the default logging level for the given duration, after which the previous default level is restored.
+// Setting the default level again with SetLevel or SetLogLevel cancels the override, and the new level stays.
+// Setting another override before expiry replaces its level and expiry time (which can be also earlier than before),
+// keeping the level to restore from before the first override.
+func (m *LogLevelManager) SetLevelFor(level slog.Level, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("failed to set level override: duration must be positive")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := m.defaultLevel.Level()
+	if existing, ok := m.overrides[defaultLevelOverrideKey]; ok {
+		previous = *existing.previous
+	}
+	m.defaultLevel.Set(level)
+
+	m.setOverrideLocked(defaultLevelOverrideKey, level, duration, &previous)
+	return nil
+}
+
+// SetLevelForServicePatternFor associates a logging level with a given dot-service-pattern for the given duration.
+// After the duration the previous level of the pattern is restored, or the pattern is removed if it didn't exist before.
+// See SetLevelForServicePattern for details about the patterns.
+//
+// Setting the level of the pattern with SetLevelForServicePattern (or SetLevels, ReplaceLevels) cancels the override,
+// and the new level stays. Setting another override before expiry replaces its level and expiry time (which can be also earlier than before),
+// keeping the level to restore from before the first override.
+func (m *LogLevelManager) SetLevelForServicePatternFor(pattern string, level slog.Level, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("failed to set level override: duration must be positive")
+	}
+	matcher, err := patternMatcherFromString(pattern, level)
+	if err != nil {
+		return fmt.Errorf("failed to set level override for pattern: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var previous *slog.Level
+	if existing, ok := m.overrides[pattern]; ok {
+		previous = existing.previous
+	} else if i := slices.IndexFunc(m.patternMatchers, func(existing *patternMatcher) bool {
+		return existing.pattern == pattern
+	}); i >= 0 {
+		previousLevel := m.patternMatchers[i].level.Level()
+		previous = &previousLevel
+	}
+	m.updateMatchersLocked(false, []*patternMatcher{matcher}, nil)
+
+	m.setOverrideLocked(pattern, level, duration, previous)
+	return nil
+}
+
+// ActiveOverrides returns the time-limited overrides which haven't expired yet, sorted by their expiry time.
+func (m *LogLevelManager) ActiveOverrides() []LevelOverride {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	overrides := make([]LevelOverride, 0, len(m.overrides))
+	for _, override := range m.overrides {
+		overrides = append(overrides, override.LevelOverride)
+	}
+	sortOverrides(overrides)
+	return overrides
+}
+
+func (m *LogLevelManager) setOverrideLocked(key string, level slog.Level, duration time.Duration, previous *slog.Level) {
+	if existing, ok := m.overrides[key]; ok {
+		existing.stopTimer()
+	}
+
+	override := &levelOverride{
+		LevelOverride: LevelOverride{
+			Pattern:   key,
+			Level:     level,
+			ExpiresAt: m.options.clock.Now().Add(duration),
+		},
+		previous: previous,
+	}
+	override.stopTimer = m.options.clock.AfterFunc(duration, func() {
+		m.expireOverride(override)
+	})
+	m.overrides[key] = override
+}
+
+func (m *LogLevelManager) cancelOverrideLocked(key string) {
+	override, ok := m.overrides[key]
+	if !ok {
+		return
+	}
+	override.stopTimer()
+	delete(m.overrides, key)
+}
+
+// expireOverride reverts the level of the expired override and calls the expiry callback.
+func (m *LogLevelManager) expireOverride(override *levelOverride) {
+	m.mu.Lock()
+	if m.overrides[override.Pattern] != override {
+		// the override has been replaced or canceled after its timer had fired
+		m.mu.Unlock()
+		return
+	}
+	delete(m.overrides, override.Pattern)
+	m.restoreLocked(override)
+	m.version.Add(1)
+	m.mu.Unlock()
+
+	if m.options.onOverrideExpired != nil {
+		m.options.onOverrideExpired(override.LevelOverride)
+	}
+}
+
+func (m *LogLevelManager) restoreLocked(override *levelOverride) {
+	switch {
+	case override.Pattern == defaultLevelOverrideKey:
+		m.defaultLevel.Set(*override.previous)
+	case override.previous == nil:
+		m.updateMatchersLocked(false, nil, []string{override.Pattern})
+	default:
+		matcher, err := patternMatcherFromString(override.Pattern, *override.previous)
+		if err != nil {
+			// the pattern was already parsed when the override was set, so it can't fail
+			panic(err)
+		}
+		m.updateMatchersLocked(false, []*patternMatcher{matcher}, nil)
+	}
+}
+
+func sortOverrides(overrides []LevelOverride) {
+	slices.SortFunc(overrides, func(a, b LevelOverride) int {
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	})
+}
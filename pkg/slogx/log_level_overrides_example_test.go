@@ -0,0 +1,42 @@
+package slogx_test
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleLogLevelManager_SetLevelForServicePatternFor() {
+	// manualClock is a fake LogLevelClock, whose time moves only with Advance
+	clock := newManualClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	manager := slogx.NewLogLevelManager(
+		slogx.LogLevelInfo,
+		slogx.WithLogLevelClock(clock),
+		slogx.WithLevelOverrideExpiredCallback(func(override slogx.LevelOverride) {
+			fmt.Printf("override of %s expired\n", override.Pattern)
+		}),
+	)
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithLevel(slogx.LogLevelDebug), slogx.WithDecorator(manager))
+	repository := slogx.Child(logger, "Repository")
+
+	err := manager.SetLevelForServicePatternFor("Repository", slog.LevelDebug, 10*time.Minute)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, override := range manager.ActiveOverrides() {
+		fmt.Printf("%s is at %s for %s\n", override.Pattern, override.Level, override.ExpiresAt.Sub(clock.Now()))
+	}
+	repository.Debug("query executed", "rows", 1)
+
+	clock.Advance(10 * time.Minute)
+	repository.Debug("query executed", "rows", 2)
+
+	// Output:
+	// Repository is at DEBUG for 10m0s
+	// level=DEBUG msg="query executed" service=Repository rows=1
+	// override of Repository expired
+}
@@ -0,0 +1,269 @@
+package slogx
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"path"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/go-softwarelab/common/pkg/is"
+	"github.com/go-softwarelab/common/pkg/seq"
+)
+
+const (
+	// anySegmentsWildcard is the segment of the dot pattern matching any number (including zero) of services.
+	anySegmentsWildcard = "**"
+	// messageConditionPrefix is the prefix of the pattern condition matching the beginning of the record message.
+	messageConditionPrefix = "message:"
+	// sourceConditionPrefix is the prefix of the pattern condition matching the source file of the record.
+	sourceConditionPrefix = "source:"
+)
+
+// Specificity of the pattern parts, used to choose the most specific pattern.
+const (
+	literalSpecificity  = 2
+	wildcardSpecificity = 1
+)
+
+// patternMatcher matches the loggers (and their records) to the level, see LogLevelManager.SetLevelForServicePattern for the syntax.
+type patternMatcher struct {
+	pattern string
+	level   *slog.LevelVar
+	// services are the segments of the dot pattern
+	services []string
+	// wildcards is true if any of the services segments is a wildcard,
+	// in such case the whole chain of services must match the segments in order
+	wildcards     bool
+	attrs         []attrCondition
+	messagePrefix string
+	source        string
+}
+
+type attrCondition struct {
+	key   string
+	value string
+}
+
+func patternMatcherFromString(pattern string, level slog.Level) (*patternMatcher, error) {
+	matcher, err := parsePattern(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pattern %q: %w", pattern, err)
+	}
+	matcher.level.Set(level)
+	return matcher, nil
+}
+
+func parsePattern(pattern string) (*patternMatcher, error) {
+	if is.BlankString(pattern) {
+		return nil, fmt.Errorf("pattern must not be empty")
+	}
+
+	matcher := &patternMatcher{
+		pattern: pattern,
+		level:   &slog.LevelVar{},
+	}
+
+	for part := range strings.SplitSeq(pattern, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "":
+			return nil, fmt.Errorf("pattern must not contain empty conditions")
+		case strings.HasPrefix(part, messageConditionPrefix):
+			if matcher.messagePrefix != "" {
+				return nil, fmt.Errorf("pattern must not contain more than one message condition")
+			}
+			matcher.messagePrefix = strings.TrimPrefix(part, messageConditionPrefix)
+			if matcher.messagePrefix == "" {
+				return nil, fmt.Errorf("message condition must not be empty")
+			}
+		case strings.HasPrefix(part, sourceConditionPrefix):
+			if matcher.source != "" {
+				return nil, fmt.Errorf("pattern must not contain more than one source condition")
+			}
+			matcher.source = strings.TrimPrefix(part, sourceConditionPrefix)
+			if _, err := path.Match(matcher.source, ""); err != nil || matcher.source == "" {
+				return nil, fmt.Errorf("invalid source condition %q", matcher.source)
+			}
+		case strings.Contains(part, "="):
+			key, value, _ := strings.Cut(part, "=")
+			if key = strings.TrimSpace(key); key == "" {
+				return nil, fmt.Errorf("attribute condition %q must have a key", part)
+			}
+			matcher.attrs = append(matcher.attrs, attrCondition{key: key, value: strings.TrimSpace(value)})
+		default:
+			if matcher.services != nil {
+				return nil, fmt.Errorf("pattern must not contain more than one dot pattern")
+			}
+			if err := matcher.parseServices(part); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return matcher, nil
+}
+
+func (m *patternMatcher) parseServices(dotPattern string) error {
+	m.services = strings.Split(dotPattern, ".")
+	for _, segment := range m.services {
+		if !strings.ContainsAny(segment, "*?[") {
+			continue
+		}
+		m.wildcards = true
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid wildcard segment %q: %w", segment, err)
+		}
+	}
+	return nil
+}
+
+// Priority returns the matcher's priority, the more specific the pattern the higher the priority.
+func (m *patternMatcher) Priority() int {
+	priority := 0
+	for _, segment := range m.services {
+		switch {
+		case segment == anySegmentsWildcard:
+		case strings.ContainsAny(segment, "*?["):
+			priority += wildcardSpecificity
+		default:
+			priority += literalSpecificity
+		}
+	}
+	priority += literalSpecificity * len(m.attrs)
+	if m.messagePrefix != "" {
+		priority += literalSpecificity
+	}
+	if m.source != "" {
+		priority += literalSpecificity
+	}
+	return priority
+}
+
+// wildcardSegments returns the number of the service segments with wildcards (including "**").
+func (m *patternMatcher) wildcardSegments() int {
+	count := 0
+	for _, segment := range m.services {
+		if strings.ContainsAny(segment, "*?[") {
+			count++
+		}
+	}
+	return count
+}
+
+// compareMatchers orders the matchers from the most specific one: by priority, then the pattern with record conditions
+// goes first (so "message:health" goes before "Orders"), then the pattern with fewer wildcard segments
+// goes first (so "Repository" goes before "**.Repository"), and finally by the pattern string to make the order deterministic.
+func compareMatchers(a, b *patternMatcher) int {
+	return cmp.Or(
+		cmp.Compare(b.Priority(), a.Priority()),
+		cmp.Compare(b.recordConditionsRank(), a.recordConditionsRank()),
+		cmp.Compare(a.wildcardSegments(), b.wildcardSegments()),
+		strings.Compare(a.pattern, b.pattern),
+	)
+}
+
+// recordConditionsRank returns 1 for the pattern with record conditions and 0 otherwise,
+// so the order of the patterns of the same priority doesn't depend on the pattern strings of different kinds.
+func (m *patternMatcher) recordConditionsRank() int {
+	if m.hasRecordConditions() {
+		return 1
+	}
+	return 0
+}
+
+// hasRecordConditions returns true if the pattern depends on the record, not only on the logger attributes.
+func (m *patternMatcher) hasRecordConditions() bool {
+	return m.messagePrefix != "" || m.source != ""
+}
+
+// matchesLogger returns true if the logger attributes match the pattern,
+// services is the chain of the logger services (values of the service attributes in order).
+func (m *patternMatcher) matchesLogger(services []string, attrs []slog.Attr) bool {
+	if m.wildcards {
+		if !matchServicesChain(m.services, services) {
+			return false
+		}
+	} else if !seq.ContainsAll(seq.FromSlice(services), m.services...) {
+		return false
+	}
+
+	for _, condition := range m.attrs {
+		if !slices.ContainsFunc(attrs, condition.matches) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesRecord returns true if the record matches the record conditions of the pattern,
+// file returns the source file of the record, it's called only if the pattern has source condition.
+func (m *patternMatcher) matchesRecord(record *slog.Record, file func() string) bool {
+	if m.messagePrefix != "" && !strings.HasPrefix(record.Message, m.messagePrefix) {
+		return false
+	}
+	if m.source != "" && !matchSourceFile(m.source, file()) {
+		return false
+	}
+	return true
+}
+
+func (c attrCondition) matches(attr slog.Attr) bool {
+	return attr.Key == c.key && attr.Value.Resolve().String() == c.value
+}
+
+// matchServicesChain returns true if the whole chain of services matches the segments,
+// where "**" matches any number of services, and other segments are matched with path.Match rules.
+func matchServicesChain(segments, services []string) bool {
+	if len(segments) == 0 {
+		return len(services) == 0
+	}
+
+	if segments[0] == anySegmentsWildcard {
+		for i := 0; i <= len(services); i++ {
+			if matchServicesChain(segments[1:], services[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(services) == 0 {
+		return false
+	}
+	matched, err := path.Match(segments[0], services[0])
+	return err == nil && matched && matchServicesChain(segments[1:], services[1:])
+}
+
+// matchSourceFile returns true if the ending of the file path, with the same number of segments as the pattern, matches the pattern.
+func matchSourceFile(pattern, file string) bool {
+	if file == "" {
+		return false
+	}
+
+	segments := strings.Count(pattern, "/") + 1
+	suffix := file
+	for i, separators := len(file)-1, 0; i >= 0; i-- {
+		if file[i] == '/' {
+			separators++
+			if separators == segments {
+				suffix = file[i+1:]
+				break
+			}
+		}
+	}
+
+	matched, err := path.Match(pattern, suffix)
+	return err == nil && matched
+}
+
+// recordSourceFile returns the source file of the record, or empty string if the record doesn't have the program counter.
+func recordSourceFile(record *slog.Record) string {
+	if record.PC == 0 {
+		return ""
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+	return frame.File
+}
@@ -0,0 +1,36 @@
+package slogx_test
+
+import (
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleLogLevelManager_SetLevelForServicePattern() {
+	manager := slogx.NewLogLevelManager(slogx.LogLevelInfo)
+	err := manager.SetLevels(map[string]any{
+		"**.Repository":               "warn",
+		"Orders.*,tenant=acme":        "debug",
+		"message:heartbeat":           "error",
+		"Payments,message:slow query": "debug",
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithLevel(slogx.LogLevelDebug), slogx.WithDecorator(manager))
+	orders := slogx.Child(logger, "Orders")
+	payments := slogx.Child(logger, "Payments")
+
+	slogx.Child(payments, "Repository").Info("payment saved")
+	slogx.Child(orders, "Repository").With("tenant", "acme").Debug("order saved")
+	slogx.Child(orders, "Repository").With("tenant", "other").Debug("order saved")
+	payments.Debug("slow query", "ms", 1200)
+	payments.Debug("payment authorized")
+	logger.Info("heartbeat")
+
+	// Output:
+	// level=DEBUG msg="order saved" service=Orders service=Repository tenant=acme
+	// level=DEBUG msg="slow query" service=Payments ms=1200
+}
@@ -17,10 +17,11 @@ func SilentLogger() *slog.Logger {
 
 // NewLoggerOptions is a set of options for slogx.NewLogger and slogx.NewTestLogger.
 type NewLoggerOptions struct {
-	level      slog.Level
-	writer     io.Writer
-	format     LogFormat
-	decorators []HandlerDecorator
+	level        slog.Level
+	writer       io.Writer
+	format       LogFormat
+	decorators   []HandlerDecorator
+	destinations []LoggerOpts
 }
 
 // LoggerOpt is an alias for functional option for slogx.NewLogger and slogx.NewTestLogger.
@@ -71,6 +72,23 @@ func WithDecorator(decorator ...HandlerDecorator) func(*NewLoggerOptions) {
 	}
 }
 
+// WithDestination adds a destination, to which the logger writes the records in addition to its main writer.
+// The destination is configured with the same options as NewLogger (with the same defaults),
+// so it can have its own level, writer, format and decorators.
+// The decorators added to the logger with WithDecorator are applied to all the destinations.
+//
+// For example, to write JSON logs at debug level to the file, and text logs at info level to the console:
+//
+//	slogx.NewLogger(
+//		slogx.WithLevel(slogx.LogLevelInfo),
+//		slogx.WithDestination(slogx.WithWriter(file), slogx.WithFormat(slogx.JSONFormat), slogx.WithLevel(slogx.LogLevelDebug)),
+//	)
+func WithDestination(opts ...func(*NewLoggerOptions)) func(*NewLoggerOptions) {
+	return func(options *NewLoggerOptions) {
+		options.destinations = append(options.destinations, opts)
+	}
+}
+
 // NewLogger creates a new slog.Logger with customizable options such as log level, writer, and format.
 func NewLogger(opts ...func(options *NewLoggerOptions)) *slog.Logger {
 	options := to.OptionsWithDefault(NewLoggerOptions{
@@ -79,10 +97,16 @@ func NewLogger(opts ...func(options *NewLoggerOptions)) *slog.Logger {
 		format: TextFormat,
 	}, opts...)
 
-	return NewBuilder().
+	builder := NewBuilder().
 		WithSlogLevel(options.level).
 		WritingTo(options.writer).
-		WithFormat(options.format).
+		WithFormat(options.format)
+
+	for _, destination := range options.destinations {
+		builder = builder.WithAdditionalHandler(NewLogger(destination...).Handler())
+	}
+
+	return builder.
 		WithHandlerDecorator(options.decorators...).
 		Logger()
 }
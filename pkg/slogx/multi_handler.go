@@ -0,0 +1,67 @@
+package slogx
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+var _ slog.Handler = (*MultiHandler)(nil)
+
+// MultiHandler is a slog.Handler that dispatches records to multiple handlers.
+// Each handler keeps its own level, format and decorators, a record is passed only to the handlers enabled for its level.
+type MultiHandler struct {
+	handlers []slog.Handler
+}
+
+// NewMultiHandler creates a new MultiHandler dispatching records to all the given handlers.
+func NewMultiHandler(handlers ...slog.Handler) *MultiHandler {
+	return &MultiHandler{handlers: handlers}
+}
+
+// Enabled returns true if any of the handlers is enabled for the level.
+func (h *MultiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h.handlers {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handle passes the record to all the handlers enabled for its level.
+// All the handlers are called even if some of them fail, the returned error joins the errors of all failed handlers.
+func (h *MultiHandler) Handle(ctx context.Context, record slog.Record) error {
+	var err error
+	for i, handler := range h.handlers {
+		if !handler.Enabled(ctx, record.Level) {
+			continue
+		}
+
+		r := record
+		if i < len(h.handlers)-1 {
+			// handlers may add attributes to the record, so each of them gets its own copy
+			r = record.Clone()
+		}
+		err = errors.Join(err, handler.Handle(ctx, r))
+	}
+	return err
+}
+
+// WithAttrs returns a new MultiHandler with the given attributes added to all the handlers.
+func (h *MultiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make([]slog.Handler, 0, len(h.handlers))
+	for _, handler := range h.handlers {
+		handlers = append(handlers, handler.WithAttrs(attrs))
+	}
+	return &MultiHandler{handlers: handlers}
+}
+
+// WithGroup returns a new MultiHandler with the given group added to all the handlers.
+func (h *MultiHandler) WithGroup(name string) slog.Handler {
+	handlers := make([]slog.Handler, 0, len(h.handlers))
+	for _, handler := range h.handlers {
+		handlers = append(handlers, handler.WithGroup(name))
+	}
+	return &MultiHandler{handlers: handlers}
+}
@@ -0,0 +1,57 @@
+package slogx_test
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleWithDestination() {
+	debugFile := slogx.NewCollectingLogsWriter()
+
+	logger := slogx.NewLogger(
+		skipTimeInLogOutputForExamplePurposes,
+		slogx.WithLevel(slogx.LogLevelInfo),
+		slogx.WithDestination(
+			slogx.WithWriter(debugFile),
+			slogx.WithFormat(slogx.TextWithoutTimeFormat),
+			slogx.WithLevel(slogx.LogLevelDebug),
+		),
+	)
+
+	logger.Debug("connecting")
+	logger.Info("connected")
+
+	fmt.Print("debug file:\n", debugFile.String())
+
+	// Output:
+	// level=INFO msg=connected
+	// debug file:
+	// level=DEBUG msg=connecting
+	// level=INFO msg=connected
+}
+
+func ExampleNewMultiHandler() {
+	replaceTime := func(_ []string, attr slog.Attr) slog.Attr {
+		if attr.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return attr
+	}
+
+	handler := slogx.NewMultiHandler(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelWarn, ReplaceAttr: replaceTime}),
+		slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo, ReplaceAttr: replaceTime}),
+	)
+	logger := slog.New(handler).With("service", "orders")
+
+	logger.Info("order created")
+	logger.Warn("payment delayed")
+
+	// Output:
+	// {"level":"INFO","msg":"order created","service":"orders"}
+	// level=WARN msg="payment delayed" service=orders
+	// {"level":"WARN","msg":"payment delayed","service":"orders"}
+}
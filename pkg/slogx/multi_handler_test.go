@@ -0,0 +1,66 @@
+package slogx_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+type failingHandler struct {
+	slog.Handler
+	err error
+}
+
+func (h failingHandler) Handle(context.Context, slog.Record) error {
+	return h.err
+}
+
+func TestMultiHandlerAggregatesErrors(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	errFirst := errors.New("first")
+	errSecond := errors.New("second")
+	handler := slogx.NewMultiHandler(
+		failingHandler{Handler: slog.DiscardHandler, err: errFirst},
+		slog.NewTextHandler(output, nil),
+		failingHandler{Handler: slog.NewTextHandler(output, nil), err: errSecond},
+	)
+
+	// when:
+	err := handler.Handle(t.Context(), slog.NewRecord(time.Now(), slog.LevelInfo, "msg", 0))
+
+	// then:
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errSecond)
+	assert.NotErrorIs(t, err, errFirst, "disabled handler shouldn't be called")
+	assert.Len(t, output.Lines(), 1, "all enabled handlers should be called despite errors")
+}
+
+func TestBuilderWithAdditionalHandlerAppliesDecoratorsToAllHandlers(t *testing.T) {
+	// given:
+	main := slogx.NewCollectingLogsWriter()
+	additional := slogx.NewCollectingLogsWriter()
+	redaction := slogx.NewRedactionDecorator()
+
+	logger := slogx.NewBuilder().
+		WithLevel(slogx.LogLevelInfo).
+		WritingTo(main).
+		WithFormat(slogx.TextWithoutTimeFormat).
+		WithAdditionalHandler(slogx.NewLogger(slogx.WithWriter(additional), slogx.WithFormat(slogx.JSONFormat)).Handler()).
+		WithHandlerDecorator(redaction).
+		Logger()
+
+	// when:
+	logger.WithGroup("auth").Info("login", "password", "secret")
+
+	// then:
+	assert.Equal(t, "level=INFO msg=login auth.password=[REDACTED]\n", main.String())
+	assert.Contains(t, additional.String(), `"auth":{"password":"[REDACTED]"}`)
+}
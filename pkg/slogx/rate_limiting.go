@@ -0,0 +1,208 @@
+package slogx
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+var _ HandlerDecorator = (*RateLimitingDecorator)(nil)
+
+// RateLimit describes a token bucket: Rate tokens per second are added to the bucket up to the Burst size,
+// and each logged record takes one token.
+type RateLimit struct {
+	// Rate is the number of records per second allowed in the long run.
+	Rate float64
+	// Burst is the maximal number of records that can be logged at once.
+	Burst int
+}
+
+// RateLimitOptions is a set of options for the RateLimitingDecorator.
+type RateLimitOptions struct {
+	limit           RateLimit
+	levelLimits     map[slog.Level]RateLimit
+	unlimited       []slog.Level
+	keyAttr         string
+	summaryInterval time.Duration
+	now             func() time.Time
+}
+
+// WithRateLimitForLevel sets the rate limit for records of the given level.
+func WithRateLimitForLevel(level slog.Level, limit RateLimit) func(*RateLimitOptions) {
+	return func(options *RateLimitOptions) {
+		options.levelLimits[level] = limit
+	}
+}
+
+// WithoutRateLimitForLevels disables rate limiting for records of the given levels, so all of them are logged.
+func WithoutRateLimitForLevels(levels ...slog.Level) func(*RateLimitOptions) {
+	return func(options *RateLimitOptions) {
+		options.unlimited = append(options.unlimited, levels...)
+	}
+}
+
+// WithRateLimitKeyAttr makes the records limited separately for each value of the attribute with the given key,
+// instead of for each level and message (which is the default).
+// The attribute is searched in the record attributes and in the attributes of the logger.
+// Only the top-level attributes of the record or the logger are matched, the attributes nested in slog.Group values are not searched.
+// The groups of the logger are ignored, so the attributes added after WithGroup are matched as well.
+// Records without the attribute share a single bucket for their level.
+func WithRateLimitKeyAttr(key string) func(*RateLimitOptions) {
+	return func(options *RateLimitOptions) {
+		options.keyAttr = key
+	}
+}
+
+// WithRateLimitSummaryInterval sets how often the summary record with the number of dropped records is logged.
+// The summary is logged at warn level with the next handled record, after the interval has passed and if any records were dropped,
+// so it's not logged until something is logged again. It's logged without the groups and attributes of the child loggers.
+// By default, it's 1 minute, zero or negative value disables the summary.
+func WithRateLimitSummaryInterval(interval time.Duration) func(*RateLimitOptions) {
+	return func(options *RateLimitOptions) {
+		options.summaryInterval = interval
+	}
+}
+
+// WithRateLimitClock sets the function returning the current time, it is useful for testing.
+func WithRateLimitClock(now func() time.Time) func(*RateLimitOptions) {
+	return func(options *RateLimitOptions) {
+		options.now = now
+	}
+}
+
+// RateLimitingDecorator is a HandlerDecorator that limits the rate of logged records with a token bucket
+// per level and message (or per value of the configured attribute).
+//
+// The buckets are shared by all handlers decorated with the same RateLimitingDecorator.
+type RateLimitingDecorator struct {
+	options RateLimitOptions
+	summary *droppedSummary
+
+	mu          sync.Mutex
+	buckets     map[rateLimitKey]*tokenBucket
+	lastCleanup time.Time
+}
+
+type rateLimitKey struct {
+	level slog.Level
+	key   string
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimitingDecorator creates a new RateLimitingDecorator with the default limit used for all levels without a specific limit.
+func NewRateLimitingDecorator(limit RateLimit, opts ...func(*RateLimitOptions)) *RateLimitingDecorator {
+	options := to.OptionsWithDefault(RateLimitOptions{
+		limit:           limit,
+		levelLimits:     make(map[slog.Level]RateLimit),
+		summaryInterval: time.Minute,
+		now:             time.Now,
+	}, opts...)
+
+	return &RateLimitingDecorator{
+		options:     options,
+		summary:     newDroppedSummary("rate_limit", options.summaryInterval, options.now),
+		buckets:     make(map[rateLimitKey]*tokenBucket),
+		lastCleanup: options.now(),
+	}
+}
+
+// DecorateHandler decorates the given handler with rate limiting of records.
+func (d *RateLimitingDecorator) DecorateHandler(handler slog.Handler, _ *DecoratorOptions) slog.Handler {
+	return newLimitingHandler(handler, d, d.summary)
+}
+
+// Dropped returns the total number of records dropped by the decorator.
+func (d *RateLimitingDecorator) Dropped() uint64 {
+	return d.summary.total.Load()
+}
+
+// bucketsCleanupInterval is how often the buckets which are full (so they're equal to the new ones) are removed.
+const bucketsCleanupInterval = time.Minute
+
+func (d *RateLimitingDecorator) allow(record *slog.Record, handlerAttrs []slog.Attr, now time.Time) bool {
+	if slices.Contains(d.options.unlimited, record.Level) {
+		return true
+	}
+
+	limit, ok := d.options.levelLimits[record.Level]
+	if !ok {
+		limit = d.options.limit
+	}
+
+	key := rateLimitKey{level: record.Level, key: d.keyOf(record, handlerAttrs)}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if now.Sub(d.lastCleanup) >= bucketsCleanupInterval {
+		d.lastCleanup = now
+		d.removeFullBuckets(now)
+	}
+
+	bucket, ok := d.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(limit.Burst), last: now}
+		d.buckets[key] = bucket
+	}
+	bucket.refill(limit, now)
+
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+func (d *RateLimitingDecorator) keyOf(record *slog.Record, handlerAttrs []slog.Attr) string {
+	if d.options.keyAttr == "" {
+		return record.Message
+	}
+
+	var value string
+	record.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == d.options.keyAttr {
+			value = attr.Value.String()
+			return false
+		}
+		return true
+	})
+	if value != "" {
+		return value
+	}
+
+	for _, attr := range slices.Backward(handlerAttrs) {
+		if attr.Key == d.options.keyAttr {
+			return attr.Value.String()
+		}
+	}
+	return ""
+}
+
+func (d *RateLimitingDecorator) removeFullBuckets(now time.Time) {
+	for key, bucket := range d.buckets {
+		limit, ok := d.options.levelLimits[key.level]
+		if !ok {
+			limit = d.options.limit
+		}
+		bucket.refill(limit, now)
+		if bucket.tokens >= float64(limit.Burst) {
+			delete(d.buckets, key)
+		}
+	}
+}
+
+func (b *tokenBucket) refill(limit RateLimit, now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	b.last = now
+}
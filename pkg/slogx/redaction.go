@@ -0,0 +1,301 @@
+package slogx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	slicesx "github.com/go-softwarelab/common/pkg/slices"
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+var (
+	_ HandlerDecorator = (*RedactionDecorator)(nil)
+	_ slog.Handler     = (*redactingHandler)(nil)
+	_ slog.LogValuer   = Secret("")
+)
+
+// RedactedValue is the replacement of sensitive values.
+const RedactedValue = "[REDACTED]"
+
+// Secret is a string that is never logged: its LogValue, String and text representations are always RedactedValue.
+// Use it for values that must never appear in logs, like passwords or tokens, even when the logger isn't decorated with RedactionDecorator.
+// Convert it back with string(secret) to get the actual value.
+type Secret string
+
+// LogValue implements slog.LogValuer interface, it always returns RedactedValue.
+func (Secret) LogValue() slog.Value {
+	return slog.StringValue(RedactedValue)
+}
+
+// String implements fmt.Stringer interface, it always returns RedactedValue.
+func (Secret) String() string {
+	return RedactedValue
+}
+
+// GoString implements fmt.GoStringer interface, it always returns RedactedValue.
+func (Secret) GoString() string {
+	return RedactedValue
+}
+
+// MarshalText implements encoding.TextMarshaler interface, it always returns RedactedValue.
+func (Secret) MarshalText() ([]byte, error) {
+	return []byte(RedactedValue), nil
+}
+
+// RedactionStrategy is a function that replaces the sensitive value with its redacted form.
+type RedactionStrategy func(value string) string
+
+// RedactFully replaces the whole value with RedactedValue.
+func RedactFully(string) string {
+	return RedactedValue
+}
+
+// RedactWithHash returns a strategy that replaces the value with a short SHA-256 hash of it,
+// so the same values can be correlated in logs without revealing them.
+// Keep in mind that hashes of low-entropy values (like short numbers) can be reversed by brute force.
+func RedactWithHash() RedactionStrategy {
+	return func(value string) string {
+		sum := sha256.Sum256([]byte(value))
+		return "sha256:" + hex.EncodeToString(sum[:8])
+	}
+}
+
+// RedactPartially returns a strategy that keeps the given number of leading and trailing characters and masks the rest with '*'.
+// Values which are too short to hide anything are replaced with RedactedValue.
+func RedactPartially(keepPrefix, keepSuffix int) RedactionStrategy {
+	return func(value string) string {
+		runes := []rune(value)
+		if len(runes) <= keepPrefix+keepSuffix {
+			return RedactedValue
+		}
+		masked := strings.Repeat("*", len(runes)-keepPrefix-keepSuffix)
+		return string(runes[:keepPrefix]) + masked + string(runes[len(runes)-keepSuffix:])
+	}
+}
+
+// Predefined patterns of sensitive values, to use with WithRedactedValuePatterns.
+var (
+	// CreditCardPattern matches credit card numbers (13 to 19 digits, optionally separated by spaces or dashes).
+	CreditCardPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	// JWTPattern matches JSON Web Tokens.
+	JWTPattern = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	// EmailPattern matches email addresses.
+	EmailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+)
+
+// DefaultRedactedKeys are the attribute keys redacted by default by RedactionDecorator.
+// Keys are matched case-insensitively, ignoring '-' and '_' characters, so "api_key", "Api-Key" and "apiKey" are the same.
+var DefaultRedactedKeys = []string{
+	"password", "passwd", "secret", "clientSecret", "token", "accessToken", "refreshToken", "idToken",
+	"authorization", "proxyAuthorization", "apiKey", "cookie", "setCookie", "privateKey",
+}
+
+// RedactionOptions is a set of options for the RedactionDecorator.
+type RedactionOptions struct {
+	useDefaultKeys bool
+	keys           []string
+	paths          []string
+	valuePatterns  []*regexp.Regexp
+	strategy       RedactionStrategy
+}
+
+// WithRedactedKeys adds attribute keys, whose values are redacted, at any level of groups nesting.
+// Keys are matched case-insensitively, ignoring '-' and '_' characters.
+func WithRedactedKeys(keys ...string) func(*RedactionOptions) {
+	return func(options *RedactionOptions) {
+		options.keys = append(options.keys, keys...)
+	}
+}
+
+// WithoutDefaultRedactedKeys disables redaction of DefaultRedactedKeys,
+// so only the keys added with WithRedactedKeys are redacted, regardless of the order of the options.
+func WithoutDefaultRedactedKeys() func(*RedactionOptions) {
+	return func(options *RedactionOptions) {
+		options.useDefaultKeys = false
+	}
+}
+
+// WithRedactedPaths adds glob patterns of attribute paths, whose values are redacted.
+// The path consists of group names and the attribute key, separated with dots, for example: "request.headers.X-Api-Key".
+// Patterns are matched with path.Match rules, where '*' matches any part of a single path segment, for example: "request.*.X-Api-*".
+// Unlike the keys, the paths are matched case-sensitively.
+func WithRedactedPaths(patterns ...string) func(*RedactionOptions) {
+	return func(options *RedactionOptions) {
+		options.paths = append(options.paths, patterns...)
+	}
+}
+
+// WithRedactedValuePatterns adds regular expressions of sensitive values, matching parts of string values are redacted
+// regardless of the attribute key. The patterns are applied also to the record message and to the text
+// of the other values (like errors or fmt.Stringer), which are replaced with the redacted text when any part matches.
+// See CreditCardPattern, JWTPattern and EmailPattern for predefined patterns.
+func WithRedactedValuePatterns(patterns ...*regexp.Regexp) func(*RedactionOptions) {
+	return func(options *RedactionOptions) {
+		options.valuePatterns = append(options.valuePatterns, patterns...)
+	}
+}
+
+// WithRedactionStrategy sets the strategy of redacting the sensitive values, by default it's RedactFully.
+func WithRedactionStrategy(strategy RedactionStrategy) func(*RedactionOptions) {
+	return func(options *RedactionOptions) {
+		options.strategy = strategy
+	}
+}
+
+// RedactionDecorator is a HandlerDecorator that redacts sensitive values of the attributes:
+// by attribute key, by glob path of the attribute inside groups, or by regular expression of the value
+// (which is also applied to the record message).
+// It redacts both attributes of the record and attributes added to the logger with With.
+type RedactionDecorator struct {
+	keys          []string
+	paths         []string
+	valuePatterns []*regexp.Regexp
+	strategy      RedactionStrategy
+}
+
+// NewRedactionDecorator creates a new RedactionDecorator configured with the given options.
+func NewRedactionDecorator(opts ...func(*RedactionOptions)) *RedactionDecorator {
+	options := to.OptionsWithDefault(RedactionOptions{
+		useDefaultKeys: true,
+		strategy:       RedactFully,
+	}, opts...)
+
+	keys := options.keys
+	if options.useDefaultKeys {
+		keys = append(slices.Clone(DefaultRedactedKeys), keys...)
+	}
+
+	paths := make([]string, 0, len(options.paths))
+	for _, pattern := range options.paths {
+		paths = append(paths, strings.ReplaceAll(pattern, ".", "/"))
+	}
+
+	return &RedactionDecorator{
+		keys:          slicesx.Map(keys, normalizeRedactedKey),
+		paths:         paths,
+		valuePatterns: options.valuePatterns,
+		strategy:      options.strategy,
+	}
+}
+
+// DecorateHandler decorates the given handler with redaction of sensitive values.
+func (d *RedactionDecorator) DecorateHandler(handler slog.Handler, _ *DecoratorOptions) slog.Handler {
+	return &redactingHandler{next: handler, redactor: d}
+}
+
+// redactAttr returns the attribute with redacted value, groups is the path of groups containing the attribute.
+func (d *RedactionDecorator) redactAttr(groups []string, attr slog.Attr) slog.Attr {
+	attr.Value = attr.Value.Resolve()
+
+	if d.isSensitiveKey(groups, attr.Key) {
+		return slog.String(attr.Key, d.strategy(attr.Value.String()))
+	}
+
+	switch attr.Value.Kind() {
+	case slog.KindGroup:
+		nestedGroups := groups
+		if attr.Key != "" {
+			// inline groups (with empty key) don't add a path segment
+			nestedGroups = append(groups[:len(groups):len(groups)], attr.Key)
+		}
+		group := attr.Value.Group()
+		redacted := make([]slog.Attr, 0, len(group))
+		for _, nested := range group {
+			redacted = append(redacted, d.redactAttr(nestedGroups, nested))
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(redacted...)}
+	case slog.KindString:
+		return slog.String(attr.Key, d.redactValue(attr.Value.String()))
+	case slog.KindAny:
+		if len(d.valuePatterns) == 0 {
+			return attr
+		}
+		// values like errors or fmt.Stringers are logged as text, so they are replaced with the redacted text if needed
+		text := attr.Value.String()
+		if redacted := d.redactValue(text); redacted != text {
+			return slog.String(attr.Key, redacted)
+		}
+		return attr
+	default:
+		return attr
+	}
+}
+
+func (d *RedactionDecorator) isSensitiveKey(groups []string, key string) bool {
+	if slices.Contains(d.keys, normalizeRedactedKey(key)) {
+		return true
+	}
+
+	if len(d.paths) == 0 {
+		return false
+	}
+	attrPath := path.Join(append(groups[:len(groups):len(groups)], key)...)
+	return slices.ContainsFunc(d.paths, func(pattern string) bool {
+		matched, err := path.Match(pattern, attrPath)
+		return err == nil && matched
+	})
+}
+
+func (d *RedactionDecorator) redactValue(value string) string {
+	for _, pattern := range d.valuePatterns {
+		value = pattern.ReplaceAllStringFunc(value, d.strategy)
+	}
+	return value
+}
+
+func normalizeRedactedKey(key string) string {
+	key = strings.ToLower(key)
+	if !strings.ContainsAny(key, "-_") {
+		return key
+	}
+	return strings.NewReplacer("-", "", "_", "").Replace(key)
+}
+
+type redactingHandler struct {
+	next     slog.Handler
+	redactor *RedactionDecorator
+	groups   []string
+}
+
+// Enabled returns true if the next handler is enabled for the level.
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle redacts the attributes of the record and passes it to the next handler.
+func (h *redactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.redactValue(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactor.redactAttr(h.groups, attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted) //nolint:wrapcheck
+}
+
+// WithAttrs returns a new handler with the given attributes redacted.
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		redacted = append(redacted, h.redactor.redactAttr(h.groups, attr))
+	}
+	return &redactingHandler{
+		next:     h.next.WithAttrs(redacted),
+		redactor: h.redactor,
+		groups:   h.groups,
+	}
+}
+
+// WithGroup returns a new handler with the given group name.
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{
+		next:     h.next.WithGroup(name),
+		redactor: h.redactor,
+		groups:   append(h.groups[:len(h.groups):len(h.groups)], name),
+	}
+}
@@ -0,0 +1,70 @@
+package slogx_test
+
+import (
+	"log/slog"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleNewRedactionDecorator() {
+	redaction := slogx.NewRedactionDecorator()
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithDecorator(redaction))
+
+	logger.Info("login", "user", "john", "password", "hunter2", slog.Group("headers", "Authorization", "Bearer abc"))
+
+	// Output:
+	// level=INFO msg=login user=john password=[REDACTED] headers.Authorization=[REDACTED]
+}
+
+func ExampleWithRedactedPaths() {
+	redaction := slogx.NewRedactionDecorator(
+		slogx.WithRedactedPaths("request.*.X-Internal-*"),
+	)
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithDecorator(redaction))
+
+	logger.Info("call",
+		slog.Group("request",
+			slog.Group("headers", "X-Internal-Signature", "sig", "Accept", "json"),
+		),
+	)
+
+	// Output:
+	// level=INFO msg=call request.headers.X-Internal-Signature=[REDACTED] request.headers.Accept=json
+}
+
+func ExampleWithRedactedValuePatterns() {
+	redaction := slogx.NewRedactionDecorator(
+		slogx.WithRedactedValuePatterns(slogx.EmailPattern, slogx.CreditCardPattern),
+		slogx.WithRedactionStrategy(slogx.RedactPartially(2, 4)),
+	)
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithDecorator(redaction))
+
+	logger.Info("payment", "details", "card 4111 1111 1111 1111 of john@example.com")
+
+	// Output:
+	// level=INFO msg=payment details="card 41*************1111 of jo**********.com"
+}
+
+func ExampleRedactWithHash() {
+	redaction := slogx.NewRedactionDecorator(
+		slogx.WithRedactedKeys("email"),
+		slogx.WithRedactionStrategy(slogx.RedactWithHash()),
+	)
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithDecorator(redaction))
+
+	logger.Info("signup", "email", "john@example.com")
+
+	// Output:
+	// level=INFO msg=signup email=sha256:855f96e983f1f8e8
+}
+
+func ExampleSecret() {
+	// Secret is redacted even without the RedactionDecorator.
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes)
+
+	apiKey := slogx.Secret("very-secret-value")
+	logger.Info("calling API", "key", apiKey)
+
+	// Output:
+	// level=INFO msg="calling API" key=[REDACTED]
+}
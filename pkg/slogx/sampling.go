@@ -0,0 +1,152 @@
+package slogx
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/to"
+)
+
+var _ HandlerDecorator = (*SamplingDecorator)(nil)
+
+// SamplingRule describes how records with the same level and message are sampled in each interval.
+type SamplingRule struct {
+	// First is the number of records logged in each interval before sampling starts.
+	First int
+	// Thereafter is the sampling rate after the First records: every Thereafter-th record is logged.
+	// Zero means that all the records after the First ones are dropped.
+	Thereafter int
+}
+
+// SamplingOptions is a set of options for the SamplingDecorator.
+type SamplingOptions struct {
+	rule            SamplingRule
+	levelRules      map[slog.Level]SamplingRule
+	unsampled       []slog.Level
+	interval        time.Duration
+	summaryInterval time.Duration
+	now             func() time.Time
+}
+
+// WithSamplingRule sets the default sampling rule, used for all levels without a specific rule.
+// By default, first 100 records per interval are logged, and every 100th thereafter.
+func WithSamplingRule(rule SamplingRule) func(*SamplingOptions) {
+	return func(options *SamplingOptions) {
+		options.rule = rule
+	}
+}
+
+// WithSamplingRuleForLevel sets the sampling rule for records of the given level.
+func WithSamplingRuleForLevel(level slog.Level, rule SamplingRule) func(*SamplingOptions) {
+	return func(options *SamplingOptions) {
+		options.levelRules[level] = rule
+	}
+}
+
+// WithoutSamplingForLevels disables sampling for records of the given levels, so all of them are logged.
+func WithoutSamplingForLevels(levels ...slog.Level) func(*SamplingOptions) {
+	return func(options *SamplingOptions) {
+		options.unsampled = append(options.unsampled, levels...)
+	}
+}
+
+// WithSamplingInterval sets the interval, after which the counters of records are reset.
+// By default, it's 1 second.
+func WithSamplingInterval(interval time.Duration) func(*SamplingOptions) {
+	return func(options *SamplingOptions) {
+		options.interval = interval
+	}
+}
+
+// WithSamplingSummaryInterval sets how often the summary record with the number of dropped records is logged.
+// The summary is logged at warn level with the next handled record, after the interval has passed and if any records were dropped,
+// so it's not logged until something is logged again. It's logged without the groups and attributes of the child loggers.
+// By default, it's 1 minute, zero or negative value disables the summary.
+func WithSamplingSummaryInterval(interval time.Duration) func(*SamplingOptions) {
+	return func(options *SamplingOptions) {
+		options.summaryInterval = interval
+	}
+}
+
+// WithSamplingClock sets the function returning the current time, it is useful for testing.
+func WithSamplingClock(now func() time.Time) func(*SamplingOptions) {
+	return func(options *SamplingOptions) {
+		options.now = now
+	}
+}
+
+// SamplingDecorator is a HandlerDecorator that samples the records with the same level and message:
+// the first N records in each interval are logged, and then only every Mth record is logged.
+// It protects the log output (and the logs storage) from floods of the same message, for example, from hot loops.
+//
+// The counters are shared by all handlers decorated with the same SamplingDecorator,
+// so the same message logged by different child loggers is sampled together.
+type SamplingDecorator struct {
+	options SamplingOptions
+	summary *droppedSummary
+
+	mu          sync.Mutex
+	windowStart time.Time
+	counters    map[samplingKey]int
+}
+
+type samplingKey struct {
+	level   slog.Level
+	message string
+}
+
+// NewSamplingDecorator creates a new SamplingDecorator configured with the given options.
+func NewSamplingDecorator(opts ...func(*SamplingOptions)) *SamplingDecorator {
+	options := to.OptionsWithDefault(SamplingOptions{
+		rule:            SamplingRule{First: 100, Thereafter: 100},
+		levelRules:      make(map[slog.Level]SamplingRule),
+		interval:        time.Second,
+		summaryInterval: time.Minute,
+		now:             time.Now,
+	}, opts...)
+
+	return &SamplingDecorator{
+		options:     options,
+		summary:     newDroppedSummary("sampling", options.summaryInterval, options.now),
+		windowStart: options.now(),
+		counters:    make(map[samplingKey]int),
+	}
+}
+
+// DecorateHandler decorates the given handler with sampling of records.
+func (d *SamplingDecorator) DecorateHandler(handler slog.Handler, _ *DecoratorOptions) slog.Handler {
+	return newLimitingHandler(handler, d, d.summary)
+}
+
+// Dropped returns the total number of records dropped by the decorator.
+func (d *SamplingDecorator) Dropped() uint64 {
+	return d.summary.total.Load()
+}
+
+func (d *SamplingDecorator) allow(record *slog.Record, _ []slog.Attr, now time.Time) bool {
+	if slices.Contains(d.options.unsampled, record.Level) {
+		return true
+	}
+
+	rule, ok := d.options.levelRules[record.Level]
+	if !ok {
+		rule = d.options.rule
+	}
+
+	d.mu.Lock()
+	if now.Sub(d.windowStart) >= d.options.interval {
+		d.windowStart = now
+		clear(d.counters)
+	}
+	key := samplingKey{level: record.Level, message: record.Message}
+	d.counters[key]++
+	count := d.counters[key]
+	d.mu.Unlock()
+
+	if count <= rule.First {
+		return true
+	}
+	return rule.Thereafter > 0 && (count-rule.First)%rule.Thereafter == 0
+}
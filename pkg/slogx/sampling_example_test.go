@@ -0,0 +1,81 @@
+package slogx_test
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func ExampleNewSamplingDecorator() {
+	sampler := slogx.NewSamplingDecorator(
+		slogx.WithSamplingRule(slogx.SamplingRule{First: 2, Thereafter: 3}),
+	)
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithDecorator(sampler))
+
+	for i := range 8 {
+		logger.Info("processing item", "item", i)
+	}
+
+	// Output:
+	// level=INFO msg="processing item" item=0
+	// level=INFO msg="processing item" item=1
+	// level=INFO msg="processing item" item=4
+	// level=INFO msg="processing item" item=7
+}
+
+func ExampleWithSamplingSummaryInterval() {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	sampler := slogx.NewSamplingDecorator(
+		slogx.WithSamplingRule(slogx.SamplingRule{First: 1}),
+		slogx.WithoutSamplingForLevels(slog.LevelError),
+		slogx.WithSamplingInterval(time.Hour),
+		slogx.WithSamplingSummaryInterval(time.Minute),
+		slogx.WithSamplingClock(func() time.Time { return now }),
+	)
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithDecorator(sampler))
+
+	for range 5 {
+		logger.Info("cache miss")
+	}
+	logger.Error("database down")
+	logger.Error("database down")
+
+	now = now.Add(time.Minute)
+	logger.Info("next message")
+
+	// Output:
+	// level=INFO msg="cache miss"
+	// level=ERROR msg="database down"
+	// level=ERROR msg="database down"
+	// level=WARN msg="dropped log records" dropped=4 reason=sampling
+	// level=INFO msg="next message"
+}
+
+func ExampleNewRateLimitingDecorator() {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	limiter := slogx.NewRateLimitingDecorator(
+		slogx.RateLimit{Rate: 1, Burst: 2},
+		slogx.WithRateLimitKeyAttr(slogx.UserIDKey),
+		slogx.WithRateLimitSummaryInterval(0),
+		slogx.WithRateLimitClock(func() time.Time { return now }),
+	)
+	logger := slogx.NewLogger(skipTimeInLogOutputForExamplePurposes, slogx.WithDecorator(limiter))
+
+	for range 3 {
+		logger.Info("request", slogx.UserID(1))
+		logger.Info("request", slogx.UserID(2))
+	}
+
+	now = now.Add(time.Second)
+	logger.Info("request", slogx.UserID(1))
+
+	// Output:
+	// level=INFO msg=request userId=1
+	// level=INFO msg=request userId=2
+	// level=INFO msg=request userId=1
+	// level=INFO msg=request userId=2
+	// level=INFO msg=request userId=1
+}
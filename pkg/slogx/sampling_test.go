@@ -0,0 +1,155 @@
+package slogx_test
+
+import (
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-softwarelab/common/pkg/slogx"
+)
+
+func TestSamplingIsSharedByChildLoggers(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	sampler := slogx.NewSamplingDecorator(slogx.WithSamplingRule(slogx.SamplingRule{First: 2}))
+	logger := slogx.NewLogger(slogx.WithWriter(output), slogx.WithDecorator(sampler))
+
+	// when:
+	for _, name := range []string{"A", "B", "C"} {
+		slogx.Child(logger, name).Info("the same message")
+	}
+
+	// then:
+	assert.Len(t, output.Lines(), 2)
+	assert.Equal(t, uint64(1), sampler.Dropped())
+}
+
+func TestSamplingResetsCountersAfterInterval(t *testing.T) {
+	// given:
+	now := time.Now()
+	output := slogx.NewCollectingLogsWriter()
+	sampler := slogx.NewSamplingDecorator(
+		slogx.WithSamplingRule(slogx.SamplingRule{First: 1}),
+		slogx.WithSamplingRuleForLevel(slog.LevelWarn, slogx.SamplingRule{First: 2}),
+		slogx.WithSamplingInterval(time.Second),
+		slogx.WithSamplingSummaryInterval(0),
+		slogx.WithSamplingClock(func() time.Time { return now }),
+	)
+	logger := slogx.NewLogger(slogx.WithWriter(output), slogx.WithDecorator(sampler))
+
+	// when:
+	for range 3 {
+		logger.Info("info")
+		logger.Warn("warn")
+	}
+	now = now.Add(time.Second)
+	logger.Info("info")
+
+	// then:
+	assert.Len(t, output.Lines(), 4)
+	assert.Equal(t, uint64(3), sampler.Dropped())
+}
+
+func TestRateLimitingRefillsTokensOverTime(t *testing.T) {
+	// given:
+	now := time.Now()
+	output := slogx.NewCollectingLogsWriter()
+	limiter := slogx.NewRateLimitingDecorator(
+		slogx.RateLimit{Rate: 2, Burst: 1},
+		slogx.WithoutRateLimitForLevels(slog.LevelError),
+		slogx.WithRateLimitSummaryInterval(0),
+		slogx.WithRateLimitClock(func() time.Time { return now }),
+	)
+	logger := slogx.NewLogger(slogx.WithWriter(output), slogx.WithDecorator(limiter))
+
+	// when:
+	logger.Info("tick")
+	logger.Info("tick")
+	logger.Error("error")
+	logger.Error("error")
+	now = now.Add(500 * time.Millisecond)
+	logger.Info("tick")
+
+	// then:
+	assert.Len(t, output.Lines(), 4)
+	assert.Equal(t, uint64(1), limiter.Dropped())
+}
+
+func TestRateLimitingKeyAttrFromChildLogger(t *testing.T) {
+	// given:
+	output := slogx.NewCollectingLogsWriter()
+	limiter := slogx.NewRateLimitingDecorator(
+		slogx.RateLimit{Rate: 0, Burst: 1},
+		slogx.WithRateLimitKeyAttr(slogx.ServiceKey),
+	)
+	logger := slogx.NewLogger(slogx.WithWriter(output), slogx.WithDecorator(limiter))
+
+	// when:
+	for range 2 {
+		slogx.Child(logger, "A").Info("a")
+		slogx.Child(logger, "B").Info("b")
+	}
+
+	// then:
+	assert.Len(t, output.Lines(), 2)
+}
+
+func TestSamplingConcurrentUse(t *testing.T) {
+	// given:
+	sampler := slogx.NewSamplingDecorator(
+		slogx.WithSamplingRule(slogx.SamplingRule{First: 10, Thereafter: 10}),
+		slogx.WithSamplingInterval(time.Hour),
+	)
+	limiter := slogx.NewRateLimitingDecorator(slogx.RateLimit{Rate: 0, Burst: 100})
+	logger := slogx.NewLogger(slogx.WithWriter(io.Discard), slogx.WithDecorator(limiter, sampler))
+
+	// when:
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				logger.Info("message")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// then:
+	assert.Equal(t, uint64(8000-10-799), sampler.Dropped())
+	assert.Equal(t, uint64(10+799-100), limiter.Dropped())
+}
+
+func TestDroppedRecordsSummaryIsLoggedWithoutChildLoggerAttrs(t *testing.T) {
+	// given:
+	now := time.Now()
+	output := slogx.NewCollectingLogsWriter()
+	sampler := slogx.NewSamplingDecorator(
+		slogx.WithSamplingRule(slogx.SamplingRule{First: 1}),
+		slogx.WithSamplingInterval(time.Hour),
+		slogx.WithSamplingSummaryInterval(time.Minute),
+		slogx.WithSamplingClock(func() time.Time { return now }),
+	)
+	logger := slogx.NewLogger(slogx.WithWriter(output), slogx.WithDecorator(sampler))
+	child := logger.With("user", "john").WithGroup("request")
+
+	// when:
+	child.Info("message")
+	child.Info("message")
+	now = now.Add(time.Minute)
+	child.Info("another message")
+
+	// then:
+	lines := output.Lines()
+	assert.Len(t, lines, 3)
+	assert.Contains(t, lines[1], slogx.DroppedRecordsMessage)
+	assert.Contains(t, lines[1], "dropped=1")
+	assert.NotContains(t, lines[1], "user=john")
+	assert.NotContains(t, lines[1], "request.")
+	assert.Contains(t, lines[2], "user=john")
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"encoding/json"
+	"iter"
+	"sync"
+)
+
+// ConcurrentSet is a thread-safe variant of Set.
+// The zero value is an empty set ready to use.
+type ConcurrentSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// NewConcurrentSet creates a new ConcurrentSet with the given elements.
+func NewConcurrentSet[T comparable](elems ...T) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: NewSet(elems...)}
+}
+
+// Add adds the given elements to the set.
+func (s *ConcurrentSet[T]) Add(elems ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.set == nil {
+		s.set = make(Set[T], len(elems))
+	}
+	s.set.Add(elems...)
+}
+
+// AddIfAbsent adds the element to the set if it is not already present.
+// Returns true if the element was added.
+func (s *ConcurrentSet[T]) AddIfAbsent(elem T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.set.Contains(elem) {
+		return false
+	}
+	if s.set == nil {
+		s.set = make(Set[T])
+	}
+	s.set.Add(elem)
+	return true
+}
+
+// Remove removes the given elements from the set.
+func (s *ConcurrentSet[T]) Remove(elems ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Remove(elems...)
+}
+
+// Contains returns true if the element is in the set.
+func (s *ConcurrentSet[T]) Contains(elem T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Contains(elem)
+}
+
+// ContainsAll returns true if all the given elements are in the set.
+func (s *ConcurrentSet[T]) ContainsAll(elems ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.ContainsAll(elems...)
+}
+
+// Len returns the number of elements in the set.
+func (s *ConcurrentSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.set)
+}
+
+// IsEmpty returns true if the set has no elements.
+func (s *ConcurrentSet[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Snapshot returns a copy of the current elements as a Set,
+// it can be used for set operations like Union or Intersection.
+func (s *ConcurrentSet[T]) Snapshot() Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Clone()
+}
+
+// All returns an iter.Seq over a snapshot of elements of the set.
+// The snapshot is taken when the iteration starts, so the set can be safely modified during the iteration.
+func (s *ConcurrentSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range s.ToSlice() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the elements of the set as a slice.
+// The order of elements is not specified.
+func (s *ConcurrentSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.ToSlice()
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// The set is marshaled as a JSON array, see Set.MarshalJSON for details.
+func (s *ConcurrentSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Snapshot())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// The set is unmarshaled from a JSON array, see Set.UnmarshalJSON for details.
+func (s *ConcurrentSet[T]) UnmarshalJSON(data []byte) error {
+	var set Set[T]
+	if err := json.Unmarshal(data, &set); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.set == nil {
+		s.set = set
+	} else {
+		s.set.Add(set.ToSlice()...)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package types
+
+import "iter"
+
+const minDequeCapacity = 8
+
+// Deque is a double-ended queue, allowing to add and remove elements on both ends.
+//
+// It is backed by a circular buffer, which grows when needed,
+// so PushFront, PushBack, PopFront and PopBack are amortized O(1), and access by index is O(1).
+// The zero value is an empty deque ready to use.
+//
+// Deque is not safe for concurrent use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// NewDeque creates a new Deque with the given elements, the first element is at the front.
+func NewDeque[T any](elems ...T) *Deque[T] {
+	d := &Deque[T]{}
+	if len(elems) > 0 {
+		d.buf = make([]T, max(len(elems), minDequeCapacity))
+		d.size = copy(d.buf, elems)
+	}
+	return d
+}
+
+// DequeFrom creates a new Deque with the elements of the given sequence, the first element is at the front.
+func DequeFrom[T any](seq iter.Seq[T]) *Deque[T] {
+	d := &Deque[T]{}
+	for e := range seq {
+		d.PushBack(e)
+	}
+	return d
+}
+
+// PushBack adds the element at the back of the deque.
+func (d *Deque[T]) PushBack(elem T) {
+	d.grow()
+	d.buf[d.index(d.size)] = elem
+	d.size++
+}
+
+// PushFront adds the element at the front of the deque.
+func (d *Deque[T]) PushFront(elem T) {
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = elem
+	d.size++
+}
+
+// PopBack removes and returns the element at the back of the deque and true,
+// or zero value and false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	i := d.index(d.size - 1)
+	elem := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	return elem, true
+}
+
+// PopFront removes and returns the element at the front of the deque and true,
+// or zero value and false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	elem := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return elem, true
+}
+
+// Front returns the element at the front of the deque and true without removing it,
+// or zero value and false if the deque is empty.
+func (d *Deque[T]) Front() (T, bool) {
+	return d.Get(0)
+}
+
+// Back returns the element at the back of the deque and true without removing it,
+// or zero value and false if the deque is empty.
+func (d *Deque[T]) Back() (T, bool) {
+	return d.Get(d.size - 1)
+}
+
+// Get returns the element at index i (counting from the front) and true,
+// or zero value and false if the index is out of range.
+func (d *Deque[T]) Get(i int) (T, bool) {
+	if i < 0 || i >= d.size {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(i)], true
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// IsEmpty returns true if the deque has no elements.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Clear removes all elements from the deque, keeping the allocated memory.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head = 0
+	d.size = 0
+}
+
+// All returns an iter.Seq over the elements of the deque, from the front to the back.
+//
+// This is useful for reusing functions provided by package seq.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iter.Seq over the elements of the deque, from the back to the front.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the elements of the deque as a slice, from the front to the back.
+func (d *Deque[T]) ToSlice() []T {
+	result := make([]T, d.size)
+	copyCircular(result, d.buf, d.head, d.size)
+	return result
+}
+
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, max(2*len(d.buf), minDequeCapacity))
+	copyCircular(buf, d.buf, d.head, d.size)
+	d.buf = buf
+	d.head = 0
+}
+
+// copyCircular copies size elements of the circular buffer src starting at head into dst.
+func copyCircular[T any](dst []T, src []T, head int, size int) {
+	n := copy(dst, src[head:min(head+size, len(src))])
+	copy(dst[n:], src[:size-n])
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleNewDeque() {
+	deque := types.NewDeque(2, 3)
+
+	deque.PushFront(1)
+	deque.PushBack(4)
+
+	fmt.Println(seq.Collect(deque.All()))
+	fmt.Println(seq.Collect(deque.Backward()))
+	// Output:
+	// [1 2 3 4]
+	// [4 3 2 1]
+}
+
+func ExampleDeque_PopFront() {
+	deque := types.NewDeque("a", "b")
+
+	fmt.Println(deque.PopFront())
+	fmt.Println(deque.PopFront())
+	fmt.Println(deque.PopFront())
+	// Output:
+	// a true
+	// b true
+	//  false
+}
+
+func ExampleDeque_PopBack() {
+	var deque types.Deque[int]
+	deque.PushBack(1)
+	deque.PushBack(2)
+
+	fmt.Println(deque.PopBack())
+	fmt.Println(deque.Len())
+	// Output:
+	// 2 true
+	// 1
+}
+
+func ExampleDequeFrom() {
+	deque := types.DequeFrom(seq.Range(0, 5))
+
+	front, _ := deque.Front()
+	back, _ := deque.Back()
+
+	fmt.Println(front, back, deque.Len())
+	// Output:
+	// 0 4 5
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func TestDequeBehavesLikeSlice(t *testing.T) {
+	var deque types.Deque[int]
+	var expected []int
+
+	for i := range 10_000 {
+		switch rand.IntN(4) {
+		case 0:
+			deque.PushBack(i)
+			expected = append(expected, i)
+		case 1:
+			deque.PushFront(i)
+			expected = append([]int{i}, expected...)
+		case 2:
+			v, ok := deque.PopBack()
+			if assert.Equal(t, len(expected) > 0, ok) && ok {
+				assert.Equal(t, expected[len(expected)-1], v)
+				expected = expected[:len(expected)-1]
+			}
+		case 3:
+			v, ok := deque.PopFront()
+			if assert.Equal(t, len(expected) > 0, ok) && ok {
+				assert.Equal(t, expected[0], v)
+				expected = expected[1:]
+			}
+		}
+	}
+
+	assert.Equal(t, len(expected), deque.Len())
+	assert.Equal(t, expected, deque.ToSlice())
+	assert.Equal(t, expected, seq.Collect(deque.All()))
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	eitherLeftSide  = "left"
+	eitherRightSide = "right"
+)
+
+// Either is a type representing a value that is one of two possible types: left (L) or right (R).
+// The zero value is a Left with zero value of L.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left creates a new Either holding the left value.
+func Left[L, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+// Right creates a new Either holding the right value.
+func Right[L, R any](value R) Either[L, R] {
+	return Either[L, R]{right: value, isRight: true}
+}
+
+// IsLeft checks if the Either holds the left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight checks if the Either holds the right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// GetLeft returns the left value and true if the Either holds the left value,
+// otherwise it returns zero value and false.
+func (e Either[L, R]) GetLeft() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// GetRight returns the right value and true if the Either holds the right value,
+// otherwise it returns zero value and false.
+func (e Either[L, R]) GetRight() (R, bool) {
+	return e.right, e.isRight
+}
+
+// LeftOrElse returns the left value if the Either holds the left value, otherwise it returns the provided default value.
+func (e Either[L, R]) LeftOrElse(defaultValue L) L {
+	if e.isRight {
+		return defaultValue
+	}
+	return e.left
+}
+
+// RightOrElse returns the right value if the Either holds the right value, otherwise it returns the provided default value.
+func (e Either[L, R]) RightOrElse(defaultValue R) R {
+	if !e.isRight {
+		return defaultValue
+	}
+	return e.right
+}
+
+// Swap returns a new Either with left and right sides swapped.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+// Fold applies onLeft or onRight function, depending on which value the Either holds, and returns its result.
+func Fold[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MapLeft maps the left value if the Either holds the left value, otherwise it keeps the right value.
+func MapLeft[L, R, L2 any](e Either[L, R], mapper func(L) L2) Either[L2, R] {
+	if e.isRight {
+		return Right[L2](e.right)
+	}
+	return Left[L2, R](mapper(e.left))
+}
+
+// MapRight maps the right value if the Either holds the right value, otherwise it keeps the left value.
+func MapRight[L, R, R2 any](e Either[L, R], mapper func(R) R2) Either[L, R2] {
+	if e.isRight {
+		return Right[L](mapper(e.right))
+	}
+	return Left[L, R2](e.left)
+}
+
+// ErrNilEitherError is the error of the Result created by ResultFromEither from the Either holding nil error on the right.
+var ErrNilEitherError = errors.New("either holds nil error on the right")
+
+// ResultFromEither creates a Result instance from an Either holding a value on the left or an error on the right.
+// The Either holding nil error on the right results in a failure with ErrNilEitherError,
+// as it's neither a value nor a meaningful error.
+func ResultFromEither[V any](e Either[V, error]) *Result[V] {
+	if e.isRight {
+		if e.right == nil {
+			return FailureResult[V](ErrNilEitherError)
+		}
+		return FailureResult[V](e.right)
+	}
+	return SuccessResult(e.left)
+}
+
+type eitherJSON struct {
+	Side  string          `json:"side"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// The Either is marshaled as an object with discriminator, like {"side":"left","value":...} or {"side":"right","value":...}.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	var (
+		value []byte
+		err   error
+		side  string
+	)
+	if e.isRight {
+		side = eitherRightSide
+		value, err = json.Marshal(e.right)
+	} else {
+		side = eitherLeftSide
+		value, err = json.Marshal(e.left)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s value of either: %w", side, err)
+	}
+
+	return json.Marshal(eitherJSON{Side: side, Value: value}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// It expects an object with discriminator, like {"side":"left","value":...} or {"side":"right","value":...}.
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	var decoded eitherJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal either: %w", err)
+	}
+
+	switch decoded.Side {
+	case eitherLeftSide:
+		var left L
+		if err := unmarshalEitherValue(decoded.Value, &left); err != nil {
+			return fmt.Errorf("failed to unmarshal left value of either: %w", err)
+		}
+		*e = Left[L, R](left)
+	case eitherRightSide:
+		var right R
+		if err := unmarshalEitherValue(decoded.Value, &right); err != nil {
+			return fmt.Errorf("failed to unmarshal right value of either: %w", err)
+		}
+		*e = Right[L](right)
+	default:
+		return fmt.Errorf("failed to unmarshal either: unexpected side %q, expected %q or %q", decoded.Side, eitherLeftSide, eitherRightSide)
+	}
+	return nil
+}
+
+func unmarshalEitherValue(data json.RawMessage, target any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, target) //nolint:wrapcheck
+}
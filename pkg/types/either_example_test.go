@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleLeft() {
+	cached := types.Left[string, int]("from cache")
+
+	fmt.Println(cached.IsLeft(), cached.IsRight())
+	fmt.Println(cached.GetLeft())
+	fmt.Println(cached.RightOrElse(-1))
+
+	// Output:
+	// true false
+	// from cache true
+	// -1
+}
+
+func ExampleRight() {
+	fresh := types.Right[string](42)
+
+	fmt.Println(fresh.IsLeft(), fresh.IsRight())
+	fmt.Println(fresh.GetRight())
+	fmt.Println(fresh.LeftOrElse("none"))
+
+	// Output:
+	// false true
+	// 42 true
+	// none
+}
+
+func ExampleFold() {
+	describe := func(e types.Either[string, int]) string {
+		return types.Fold(e,
+			func(s string) string { return "text: " + s },
+			func(n int) string { return "number: " + strconv.Itoa(n) },
+		)
+	}
+
+	fmt.Println(describe(types.Left[string, int]("hello")))
+	fmt.Println(describe(types.Right[string](42)))
+
+	// Output:
+	// text: hello
+	// number: 42
+}
+
+func ExampleMapLeft() {
+	e := types.Left[string, int]("hello")
+
+	mapped := types.MapLeft(e, func(s string) int { return len(s) })
+
+	fmt.Println(mapped.GetLeft())
+
+	// Output:
+	// 5 true
+}
+
+func ExampleMapRight() {
+	e := types.Right[string](21)
+
+	mapped := types.MapRight(e, func(n int) int { return n * 2 })
+
+	fmt.Println(mapped.GetRight())
+
+	// Output:
+	// 42 true
+}
+
+func ExampleEither_Swap() {
+	e := types.Left[string, int]("hello")
+
+	swapped := e.Swap()
+
+	fmt.Println(swapped.IsRight())
+	fmt.Println(swapped.GetRight())
+
+	// Output:
+	// true
+	// hello true
+}
+
+func ExampleResultFromEither() {
+	success := types.ResultFromEither(types.Left[int, error](42))
+	fmt.Println(success.Get())
+
+	failure := types.ResultFromEither(types.Right[int](errors.New("failed")))
+	fmt.Println(failure.Get())
+
+	nilError := types.ResultFromEither(types.Right[int, error](nil))
+	fmt.Println(nilError.Get())
+
+	// Output:
+	// 42 <nil>
+	// 0 failed
+	// 0 either holds nil error on the right
+}
+
+func ExampleEither_MarshalJSON() {
+	type payloadV1 struct {
+		Name string `json:"name"`
+	}
+	type payloadV2 struct {
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+	}
+
+	payloads := []types.Either[payloadV1, payloadV2]{
+		types.Left[payloadV1, payloadV2](payloadV1{Name: "John Doe"}),
+		types.Right[payloadV1](payloadV2{FirstName: "Jane", LastName: "Doe"}),
+	}
+
+	data, _ := json.Marshal(payloads)
+	fmt.Println(string(data))
+
+	var decoded []types.Either[payloadV1, payloadV2]
+	_ = json.Unmarshal(data, &decoded)
+	fmt.Println(decoded[0].IsLeft(), decoded[1].IsRight())
+
+	// Output:
+	// [{"side":"left","value":{"name":"John Doe"}},{"side":"right","value":{"firstName":"Jane","lastName":"Doe"}}]
+	// true true
+}
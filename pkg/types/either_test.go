@@ -0,0 +1,22 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func TestResultFromEitherWithNilError(t *testing.T) {
+	// given:
+	either := types.Right[string, error](nil)
+
+	// when:
+	result := types.ResultFromEither(either)
+
+	// then:
+	assert.True(t, result.IsError())
+	assert.ErrorIs(t, result.GetError(), types.ErrNilEitherError)
+	assert.Empty(t, result.OrElse(""))
+}
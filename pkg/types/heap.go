@@ -0,0 +1,288 @@
+package types
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Heap is a priority queue backed by a binary heap.
+//
+// The element with the highest priority is the one which is the lowest according to the cmp function,
+// so a heap created with cmp.Compare is a min-heap. Reverse the cmp function to get a max-heap.
+// Push, Pop, Fix and Remove operations are O(log n), Peek is O(1).
+//
+// Heap is not safe for concurrent use.
+type Heap[T any] struct {
+	elems []T
+	cmp   func(a, b T) int
+}
+
+// NewHeap creates a new Heap ordered by the cmp function, with the given elements.
+// The heap is built from the elements in O(n), the given slice is copied and left unchanged.
+func NewHeap[T any](cmp func(a, b T) int, elems ...T) *Heap[T] {
+	return newHeap(cmp, slices.Clone(elems))
+}
+
+// NewOrderedHeap creates a new min-Heap of ordered elements, with the given elements.
+// The heap is built from the elements in O(n).
+func NewOrderedHeap[T Ordered](elems ...T) *Heap[T] {
+	return NewHeap(cmp.Compare[T], elems...)
+}
+
+// HeapFrom creates a new Heap ordered by the cmp function, with the elements of the given sequence.
+// The heap is built from the elements in O(n).
+func HeapFrom[T any](seq iter.Seq[T], cmp func(a, b T) int) *Heap[T] {
+	return newHeap(cmp, slices.Collect(seq))
+}
+
+// OrderedHeapFrom creates a new min-Heap with the ordered elements of the given sequence.
+// The heap is built from the elements in O(n).
+func OrderedHeapFrom[T Ordered](seq iter.Seq[T]) *Heap[T] {
+	return HeapFrom(seq, cmp.Compare[T])
+}
+
+// newHeap creates a new Heap taking the ownership of the given slice.
+func newHeap[T any](cmp func(a, b T) int, elems []T) *Heap[T] {
+	h := &Heap[T]{elems: elems, cmp: cmp}
+	h.init()
+	return h
+}
+
+// Push adds the element to the heap.
+func (h *Heap[T]) Push(elem T) {
+	h.elems = append(h.elems, elem)
+	h.up(len(h.elems) - 1)
+}
+
+// Pop removes and returns the element with the highest priority and true,
+// or zero value and false if the heap is empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.elems) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.Remove(0), true
+}
+
+// Peek returns the element with the highest priority and true without removing it,
+// or zero value and false if the heap is empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.elems) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.elems[0], true
+}
+
+// IndexFunc returns the index of the first element (in the heap's internal order) satisfying the predicate, or -1 if none do.
+// The index can be used with Fix and Remove, it is valid until the next modification of the heap.
+func (h *Heap[T]) IndexFunc(predicate func(T) bool) int {
+	for i, e := range h.elems {
+		if predicate(e) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Set replaces the element at index i with the given element and restores the heap ordering.
+// It panics if i is out of range.
+func (h *Heap[T]) Set(i int, elem T) {
+	h.elems[i] = elem
+	h.Fix(i)
+}
+
+// Fix restores the heap ordering after the element at index i has changed its priority.
+// Changing the priority and then calling Fix is cheaper than calling Remove followed by Push.
+// It panics if i is out of range.
+func (h *Heap[T]) Fix(i int) {
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// Remove removes and returns the element at index i.
+// It panics if i is out of range.
+func (h *Heap[T]) Remove(i int) T {
+	last := len(h.elems) - 1
+	removed := h.elems[i]
+	if i != last {
+		h.elems[i] = h.elems[last]
+	}
+
+	var zero T
+	h.elems[last] = zero
+	h.elems = h.elems[:last]
+
+	if i != last {
+		h.Fix(i)
+	}
+	return removed
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.elems)
+}
+
+// IsEmpty returns true if the heap has no elements.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.elems) == 0
+}
+
+// All returns an iter.Seq over the elements of the heap without removing them.
+// The iteration order is not specified, use Drain to get the elements in priority order.
+func (h *Heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range h.elems {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iter.Seq that pops the elements from the heap in priority order.
+// If the iteration is stopped early, the remaining elements stay in the heap.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			e, ok := h.Pop()
+			if !ok || !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (h *Heap[T]) init() {
+	for i := len(h.elems)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+func (h *Heap[T]) less(i, j int) bool {
+	return h.cmp(h.elems[i], h.elems[j]) < 0
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(i, parent) {
+			break
+		}
+		h.elems[i], h.elems[parent] = h.elems[parent], h.elems[i]
+		i = parent
+	}
+}
+
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	n := len(h.elems)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(right, child) {
+			child = right
+		}
+		if !h.less(child, i) {
+			break
+		}
+		h.elems[i], h.elems[child] = h.elems[child], h.elems[i]
+		i = child
+	}
+	return i > start
+}
+
+// BoundedHeap is a priority queue that keeps at most capacity elements with the highest priority.
+// When it is full, pushing a new element drops the element with the lowest priority.
+//
+// It is useful for Top-K computations, as it needs O(k) memory and O(log k) time per pushed element.
+// Like Heap, the element with the highest priority is the one which is the lowest according to the cmp function.
+//
+// BoundedHeap is not safe for concurrent use.
+type BoundedHeap[T any] struct {
+	capacity int
+	// heap with reversed ordering, so the element with the lowest priority is on top and can be dropped quickly
+	heap *Heap[T]
+}
+
+// NewBoundedHeap creates a new BoundedHeap with the given capacity, ordered by the cmp function.
+// It panics if capacity is not positive.
+func NewBoundedHeap[T any](capacity int, cmp func(a, b T) int) *BoundedHeap[T] {
+	if capacity <= 0 {
+		panic("types: BoundedHeap capacity must be positive")
+	}
+
+	reversed := func(a, b T) int {
+		return cmp(b, a)
+	}
+	return &BoundedHeap[T]{
+		capacity: capacity,
+		heap:     &Heap[T]{elems: make([]T, 0, capacity), cmp: reversed},
+	}
+}
+
+// NewOrderedBoundedHeap creates a new BoundedHeap with the given capacity, which keeps the lowest ordered elements.
+// It panics if capacity is not positive.
+func NewOrderedBoundedHeap[T Ordered](capacity int) *BoundedHeap[T] {
+	return NewBoundedHeap(capacity, cmp.Compare[T])
+}
+
+// Push adds the element to the heap.
+// If the heap was full, it returns the dropped element with the lowest priority (which can be the pushed element itself) and true,
+// otherwise it returns zero value and false.
+func (h *BoundedHeap[T]) Push(elem T) (dropped T, ok bool) {
+	if h.heap.Len() < h.capacity {
+		h.heap.Push(elem)
+		return dropped, false
+	}
+
+	lowest, _ := h.heap.Peek()
+	if h.heap.cmp(elem, lowest) <= 0 {
+		return elem, true
+	}
+
+	h.heap.Set(0, elem)
+	return lowest, true
+}
+
+// Len returns the number of elements in the heap.
+func (h *BoundedHeap[T]) Len() int {
+	return h.heap.Len()
+}
+
+// Capacity returns the maximum number of elements kept in the heap.
+func (h *BoundedHeap[T]) Capacity() int {
+	return h.capacity
+}
+
+// IsFull returns true if the heap holds capacity elements, so the next Push drops an element.
+func (h *BoundedHeap[T]) IsFull() bool {
+	return h.heap.Len() == h.capacity
+}
+
+// Drain returns an iter.Seq that removes the elements from the heap and yields them in priority order.
+// If the iteration is stopped early, the remaining elements stay in the heap.
+// The heap mustn't be modified during the iteration.
+func (h *BoundedHeap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// the elements are moved to a heap with the priority ordering, so they can be popped lazily
+		ordered := newHeap(func(a, b T) int { return h.heap.cmp(b, a) }, h.heap.elems)
+		h.heap.elems = nil
+		defer func() {
+			h.heap.elems = ordered.elems
+			h.heap.init()
+		}()
+
+		for {
+			e, ok := ordered.Pop()
+			if !ok || !yield(e) {
+				return
+			}
+		}
+	}
+}
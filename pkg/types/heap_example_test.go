@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleNewOrderedHeap() {
+	heap := types.NewOrderedHeap(5, 1, 4, 2, 3)
+
+	fmt.Println(seq.Collect(heap.Drain()))
+	// Output:
+	// [1 2 3 4 5]
+}
+
+func ExampleNewHeap() {
+	type task struct {
+		name     string
+		priority int
+	}
+
+	// higher priority first
+	heap := types.NewHeap(func(a, b task) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+	heap.Push(task{name: "write docs", priority: 1})
+	heap.Push(task{name: "fix bug", priority: 10})
+	heap.Push(task{name: "review", priority: 5})
+
+	next, _ := heap.Peek()
+	fmt.Println("next:", next.name)
+
+	for t := range heap.Drain() {
+		fmt.Println(t.name)
+	}
+	// Output:
+	// next: fix bug
+	// fix bug
+	// review
+	// write docs
+}
+
+func ExampleHeap_Pop() {
+	heap := types.NewOrderedHeap(3, 1, 2)
+
+	first, ok := heap.Pop()
+	fmt.Println(first, ok, heap.Len())
+
+	heap.Pop()
+	heap.Pop()
+	_, ok = heap.Pop()
+	fmt.Println(ok)
+	// Output:
+	// 1 true 2
+	// false
+}
+
+func ExampleHeap_Fix() {
+	type item struct {
+		name  string
+		value int
+	}
+
+	heap := types.NewHeap(func(a, b *item) int {
+		return cmp.Compare(a.value, b.value)
+	}, &item{"a", 1}, &item{"b", 2}, &item{"c", 3})
+
+	i := heap.IndexFunc(func(it *item) bool { return it.name == "c" })
+	for it := range heap.All() {
+		if it.name == "c" {
+			it.value = 0
+		}
+	}
+	heap.Fix(i)
+
+	top, _ := heap.Peek()
+	fmt.Println(top.name)
+	// Output:
+	// c
+}
+
+func ExampleHeap_Remove() {
+	heap := types.NewOrderedHeap(1, 2, 3, 4)
+
+	removed := heap.Remove(heap.IndexFunc(func(v int) bool { return v == 3 }))
+
+	fmt.Println(removed, seq.Collect(heap.Drain()))
+	// Output:
+	// 3 [1 2 4]
+}
+
+func ExampleOrderedHeapFrom() {
+	heap := types.OrderedHeapFrom(seq.Of("banana", "apple", "cherry"))
+
+	first, _ := heap.Peek()
+	fmt.Println(first)
+	// Output:
+	// apple
+}
+
+func ExampleNewBoundedHeap() {
+	// keep 3 largest numbers, so the highest priority is for the greatest number
+	top3 := types.NewBoundedHeap(3, func(a, b int) int {
+		return cmp.Compare(b, a)
+	})
+
+	for _, v := range []int{5, 1, 9, 3, 7, 2} {
+		top3.Push(v)
+	}
+
+	fmt.Println(seq.Collect(top3.Drain()))
+	// Output:
+	// [9 7 5]
+}
+
+func ExampleBoundedHeap_Push() {
+	heap := types.NewOrderedBoundedHeap[int](2)
+
+	fmt.Println(heap.Push(3))
+	fmt.Println(heap.Push(1))
+	fmt.Println(heap.Push(2))
+	fmt.Println(heap.Push(5))
+	// Output:
+	// 0 false
+	// 0 false
+	// 3 true
+	// 5 true
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func TestHeapDrainsInOrder(t *testing.T) {
+	// given:
+	heap := types.NewOrderedHeap(rand.Perm(1000)...)
+
+	// when:
+	for i := 0; i < 100; i++ {
+		heap.Remove(rand.IntN(heap.Len()))
+	}
+	for i := 0; i < 100; i++ {
+		heap.Push(rand.IntN(2000))
+	}
+	drained := seq.Collect(heap.Drain())
+
+	// then:
+	assert.Len(t, drained, 1000)
+	assert.True(t, slices.IsSorted(drained))
+	assert.True(t, heap.IsEmpty())
+}
+
+func TestHeapDoesNotModifyGivenElements(t *testing.T) {
+	// given:
+	values := []int{5, 3, 4, 1, 2}
+
+	// when:
+	heap := types.NewOrderedHeap(values...)
+	heap.Pop()
+	heap.Push(0)
+
+	// then:
+	assert.Equal(t, []int{5, 3, 4, 1, 2}, values)
+	assert.Equal(t, []int{0, 2, 3, 4, 5}, seq.Collect(heap.Drain()))
+}
+
+func TestBoundedHeapKeepsTopElements(t *testing.T) {
+	// given:
+	heap := types.NewOrderedBoundedHeap[int](10)
+
+	// when:
+	for _, v := range rand.Perm(1000) {
+		heap.Push(v)
+	}
+	drained := seq.Collect(heap.Drain())
+
+	// then:
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, drained)
+	assert.Equal(t, 0, heap.Len())
+}
+
+func TestBoundedHeapDrainStoppedEarlyKeepsRemainingElements(t *testing.T) {
+	// given:
+	heap := types.NewOrderedBoundedHeap[int](5)
+	for _, v := range rand.Perm(10) {
+		heap.Push(v)
+	}
+
+	// when:
+	var first []int
+	for v := range heap.Drain() {
+		first = append(first, v)
+		if len(first) == 2 {
+			break
+		}
+	}
+
+	// then:
+	assert.Equal(t, []int{0, 1}, first)
+	assert.Equal(t, 3, heap.Len())
+
+	// when:
+	dropped, ok := heap.Push(10)
+
+	// then:
+	assert.False(t, ok, "heap shouldn't be full, but dropped %d", dropped)
+	assert.Equal(t, []int{2, 3, 4, 10}, seq.Collect(heap.Drain()))
+	assert.Equal(t, 0, heap.Len())
+}
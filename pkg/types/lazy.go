@@ -0,0 +1,173 @@
+package types
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy is a value computed on the first access and cached for all the subsequent accesses.
+//
+// It's a replacement for sync.Once wrappers around expensive initialization.
+// If the function panics, the panic is propagated to the caller and the value is computed again on the next access.
+//
+// Lazy is safe for concurrent use, the function is executed at most once at a time.
+type Lazy[T any] struct {
+	core lazyCore[T]
+}
+
+// NewLazy creates a new Lazy value computed with the given function.
+func NewLazy[T any](compute func() T) *Lazy[T] {
+	return &Lazy[T]{core: lazyCore[T]{
+		compute: func() (T, error) {
+			return compute(), nil
+		},
+	}}
+}
+
+// Get returns the value, computing it if this is the first access.
+func (l *Lazy[T]) Get() T {
+	value, _ := l.core.get()
+	return value
+}
+
+// Peek returns the value and true if it has been already computed, otherwise it returns zero value and false.
+// It never triggers the computation.
+//
+// Use optional.PeekLazy to get the result as optional.Value.
+func (l *Lazy[T]) Peek() (T, bool) {
+	return l.core.peek()
+}
+
+// LazyOptions is a set of options for LazyErr.
+type LazyOptions struct {
+	retryOnError bool
+}
+
+// WithRetryOnError makes LazyErr not cache the error, so the next access executes the function again.
+// By default, the error is cached like a value.
+func WithRetryOnError() func(*LazyOptions) {
+	return func(options *LazyOptions) {
+		options.retryOnError = true
+	}
+}
+
+// LazyErr is a value computed with a function that can fail, on the first access, and cached for all the subsequent accesses.
+//
+// By default, the error is cached as well, use WithRetryOnError option to compute the value again after the failure.
+// If the function panics, the panic is propagated to the caller and the value is computed again on the next access.
+//
+// LazyErr is safe for concurrent use, the function is executed at most once at a time.
+type LazyErr[T any] struct {
+	core lazyCore[T]
+}
+
+// NewLazyErr creates a new LazyErr value computed with the given function.
+func NewLazyErr[T any](compute func() (T, error), opts ...func(*LazyOptions)) *LazyErr[T] {
+	var options LazyOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &LazyErr[T]{core: lazyCore[T]{
+		compute:      compute,
+		retryOnError: options.retryOnError,
+	}}
+}
+
+// Get returns the value or the error, computing them if this is the first access
+// (or if the previous computation failed and WithRetryOnError option is used).
+func (l *LazyErr[T]) Get() (T, error) {
+	return l.core.get()
+}
+
+// Peek returns the value and true if it has been already computed successfully, otherwise it returns zero value and false.
+// It never triggers the computation.
+//
+// The cached error is not reported by Peek, it returns false as if the value hasn't been computed yet,
+// use Get to get the error.
+//
+// Use optional.PeekLazy to get the result as optional.Value.
+func (l *LazyErr[T]) Peek() (T, bool) {
+	return l.core.peek()
+}
+
+// ResettableLazy is a lazy value that can be reset, so it is computed again on the next access.
+// It's useful for values that need to be refreshed from time to time, like configuration or credentials.
+//
+// Errors are never cached, the next access after the failure executes the function again.
+// If the function panics, the panic is propagated to the caller and the value is computed again on the next access.
+//
+// ResettableLazy is safe for concurrent use, the function is executed at most once at a time.
+type ResettableLazy[T any] struct {
+	core lazyCore[T]
+}
+
+// NewResettableLazy creates a new ResettableLazy value computed with the given function.
+func NewResettableLazy[T any](compute func() (T, error)) *ResettableLazy[T] {
+	return &ResettableLazy[T]{core: lazyCore[T]{
+		compute:      compute,
+		retryOnError: true,
+	}}
+}
+
+// Get returns the value or the error, computing them if the value hasn't been computed since the creation or the last Reset.
+func (l *ResettableLazy[T]) Get() (T, error) {
+	return l.core.get()
+}
+
+// Peek returns the value and true if it has been already computed, otherwise it returns zero value and false.
+// It never triggers the computation.
+//
+// Use optional.PeekLazy to get the result as optional.Value.
+func (l *ResettableLazy[T]) Peek() (T, bool) {
+	return l.core.peek()
+}
+
+// Reset forgets the computed value, so it is computed again on the next access.
+// If the value is being computed at the moment, Reset waits for the computation to finish.
+func (l *ResettableLazy[T]) Reset() {
+	l.core.mu.Lock()
+	defer l.core.mu.Unlock()
+
+	l.core.result.Store(nil)
+}
+
+type lazyCore[T any] struct {
+	mu           sync.Mutex
+	result       atomic.Pointer[lazyResult[T]]
+	compute      func() (T, error)
+	retryOnError bool
+}
+
+type lazyResult[T any] struct {
+	value T
+	err   error
+}
+
+func (l *lazyCore[T]) get() (T, error) {
+	if result := l.result.Load(); result != nil {
+		return result.value, result.err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if result := l.result.Load(); result != nil {
+		return result.value, result.err
+	}
+
+	value, err := l.compute()
+	if err == nil || !l.retryOnError {
+		l.result.Store(&lazyResult[T]{value: value, err: err})
+	}
+	return value, err
+}
+
+func (l *lazyCore[T]) peek() (T, bool) {
+	result := l.result.Load()
+	if result == nil || result.err != nil {
+		var zero T
+		return zero, false
+	}
+	return result.value, true
+}
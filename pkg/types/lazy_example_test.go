@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleNewLazy() {
+	config := types.NewLazy(func() map[string]string {
+		fmt.Println("loading config")
+		return map[string]string{"env": "prod"}
+	})
+
+	_, computed := config.Peek()
+	fmt.Println("computed:", computed)
+
+	fmt.Println(config.Get()["env"])
+	fmt.Println(config.Get()["env"])
+	// Output:
+	// computed: false
+	// loading config
+	// prod
+	// prod
+}
+
+func ExampleNewLazyErr() {
+	attempts := 0
+	connection := types.NewLazyErr(func() (string, error) {
+		attempts++
+		return "", errors.New("connection refused")
+	})
+
+	fmt.Println(connection.Get())
+	fmt.Println(connection.Get())
+	fmt.Println("attempts:", attempts)
+	// Output:
+	//  connection refused
+	//  connection refused
+	// attempts: 1
+}
+
+func ExampleWithRetryOnError() {
+	attempts := 0
+	connection := types.NewLazyErr(func() (string, error) {
+		attempts++
+		if attempts == 1 {
+			return "", errors.New("connection refused")
+		}
+		return "connected", nil
+	}, types.WithRetryOnError())
+
+	fmt.Println(connection.Get())
+	fmt.Println(connection.Get())
+	fmt.Println(connection.Get())
+	fmt.Println("attempts:", attempts)
+	// Output:
+	//  connection refused
+	// connected <nil>
+	// connected <nil>
+	// attempts: 2
+}
+
+func ExampleResettableLazy_Reset() {
+	version := 0
+	credentials := types.NewResettableLazy(func() (string, error) {
+		version++
+		return fmt.Sprintf("token-v%d", version), nil
+	})
+
+	fmt.Println(credentials.Get())
+
+	credentials.Reset()
+	_, computed := credentials.Peek()
+	fmt.Println("computed after reset:", computed)
+
+	fmt.Println(credentials.Get())
+	// Output:
+	// token-v1 <nil>
+	// computed after reset: false
+	// token-v2 <nil>
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func TestLazyComputesOnceUnderConcurrentAccess(t *testing.T) {
+	var calls atomic.Int32
+	lazy := types.NewLazy(func() int {
+		calls.Add(1)
+		return 42
+	})
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 42, lazy.Get())
+			_, _ = lazy.Peek()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestLazyRecomputesAfterPanic(t *testing.T) {
+	shouldPanic := true
+	lazy := types.NewLazy(func() int {
+		if shouldPanic {
+			panic("boom")
+		}
+		return 42
+	})
+
+	assert.Panics(t, func() { lazy.Get() })
+	_, computed := lazy.Peek()
+	assert.False(t, computed)
+
+	shouldPanic = false
+	assert.Equal(t, 42, lazy.Get())
+}
+
+func TestResettableLazyConcurrentResetAndGet(t *testing.T) {
+	var calls atomic.Int32
+	lazy := types.NewResettableLazy(func() (int32, error) {
+		return calls.Add(1), nil
+	})
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := lazy.Get()
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			lazy.Reset()
+		}()
+	}
+	wg.Wait()
+
+	v, err := lazy.Get()
+	assert.NoError(t, err)
+	assert.Positive(t, v)
+}
+
+func TestLazyErrPeekDoesNotReportCachedError(t *testing.T) {
+	var calls atomic.Int32
+	failure := errors.New("failure")
+	lazy := types.NewLazyErr(func() (int, error) {
+		calls.Add(1)
+		return 0, failure
+	})
+
+	_, err := lazy.Get()
+	assert.ErrorIs(t, err, failure)
+
+	_, computed := lazy.Peek()
+	assert.False(t, computed)
+
+	_, err = lazy.Get()
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, int32(1), calls.Load())
+}
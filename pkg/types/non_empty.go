@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// ErrEmptyNonEmpty is returned when unmarshaling an empty JSON array into NonEmpty.
+var ErrEmptyNonEmpty = errors.New("non-empty collection cannot be empty")
+
+// NonEmpty is an immutable collection with at least one element.
+//
+// Thanks to the guarantee of non-emptiness, operations like Head, Last, Reduce or Max return plain values,
+// instead of optional values or errors, so the emptiness needs to be validated only once, when NonEmpty is created.
+//
+// Create it with NewNonEmpty or NonEmptyFrom. The zero value of NonEmpty is not valid:
+// the methods returning a single element, like Head, Reduce or MaxFunc, panic on it, and Len returns 0.
+type NonEmpty[T any] struct {
+	elems []T
+}
+
+// NewNonEmpty creates a new NonEmpty with the given head and the rest of elements.
+func NewNonEmpty[T any](head T, tail ...T) NonEmpty[T] {
+	elems := make([]T, 0, len(tail)+1)
+	elems = append(elems, head)
+	return NonEmpty[T]{elems: append(elems, tail...)}
+}
+
+// NonEmptyFrom creates a new NonEmpty with a copy of the given slice and true,
+// or returns zero value and false if the slice is empty.
+//
+// Use optional.NonEmptyFrom to get the result as optional.Value.
+func NonEmptyFrom[T any](slice []T) (NonEmpty[T], bool) {
+	if len(slice) == 0 {
+		return NonEmpty[T]{}, false
+	}
+	return NonEmpty[T]{elems: slices.Clone(slice)}, true
+}
+
+// Head returns the first element.
+func (n NonEmpty[T]) Head() T {
+	return n.elems[0]
+}
+
+// Last returns the last element.
+func (n NonEmpty[T]) Last() T {
+	return n.elems[len(n.elems)-1]
+}
+
+// Tail returns all the elements except the first one, it may be an empty slice.
+// It returns nil for the zero value of NonEmpty.
+func (n NonEmpty[T]) Tail() []T {
+	if len(n.elems) == 0 {
+		return nil
+	}
+	return slices.Clone(n.elems[1:])
+}
+
+// Get returns the element at index i and true, or zero value and false if the index is out of range.
+func (n NonEmpty[T]) Get(i int) (T, bool) {
+	if i < 0 || i >= len(n.elems) {
+		var zero T
+		return zero, false
+	}
+	return n.elems[i], true
+}
+
+// Len returns the number of elements, it's at least 1 for every NonEmpty except the zero value, for which it's 0.
+func (n NonEmpty[T]) Len() int {
+	return len(n.elems)
+}
+
+// Reduce combines all the elements into a single value with the accumulator function, starting with the head.
+func (n NonEmpty[T]) Reduce(accumulator func(agg T, item T) T) T {
+	result := n.elems[0]
+	for _, e := range n.elems[1:] {
+		result = accumulator(result, e)
+	}
+	return result
+}
+
+// MaxFunc returns the maximal element according to the cmp function.
+// If there are multiple maximal elements, the first one is returned.
+func (n NonEmpty[T]) MaxFunc(cmp func(a, b T) int) T {
+	return slices.MaxFunc(n.elems, cmp)
+}
+
+// MinFunc returns the minimal element according to the cmp function.
+// If there are multiple minimal elements, the first one is returned.
+func (n NonEmpty[T]) MinFunc(cmp func(a, b T) int) T {
+	return slices.MinFunc(n.elems, cmp)
+}
+
+// All returns an iter.Seq over the elements.
+//
+// This is useful for reusing functions provided by package seq.
+func (n NonEmpty[T]) All() iter.Seq[T] {
+	return slices.Values(n.elems)
+}
+
+// ToSlice returns a copy of the elements as a slice.
+func (n NonEmpty[T]) ToSlice() []T {
+	return slices.Clone(n.elems)
+}
+
+// MarshalJSON implements json.Marshaler interface, the collection is marshaled as a JSON array.
+func (n NonEmpty[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.elems) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// The collection is unmarshaled from a JSON array, empty arrays and null are rejected with ErrEmptyNonEmpty.
+func (n *NonEmpty[T]) UnmarshalJSON(data []byte) error {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return fmt.Errorf("failed to unmarshal non-empty collection: %w", err)
+	}
+	if len(elems) == 0 {
+		return ErrEmptyNonEmpty
+	}
+
+	n.elems = elems
+	return nil
+}
+
+// NonEmptyMax returns the maximal element of the NonEmpty collection.
+func NonEmptyMax[T Ordered](n NonEmpty[T]) T {
+	return slices.Max(n.elems)
+}
+
+// NonEmptyMin returns the minimal element of the NonEmpty collection.
+func NonEmptyMin[T Ordered](n NonEmpty[T]) T {
+	return slices.Min(n.elems)
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/optional"
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleNonEmptyFrom() {
+	scores, ok := types.NonEmptyFrom([]int{3, 7, 5})
+	if !ok {
+		fmt.Println("no scores")
+		return
+	}
+
+	fmt.Println(scores.Head(), scores.Last(), scores.Tail())
+	fmt.Println(types.NonEmptyMax(scores), types.NonEmptyMin(scores))
+
+	_, ok = types.NonEmptyFrom([]int{})
+	fmt.Println(ok)
+	// Output:
+	// 3 5 [7 5]
+	// 7 3
+	// false
+}
+
+func ExampleNonEmptyFrom_optional() {
+	names := optional.OfOK(types.NonEmptyFrom([]string{"Alice", "Bob"}))
+
+	fmt.Println(optional.Map(names, types.NonEmpty[string].Head).OrZeroValue())
+	// Output:
+	// Alice
+}
+
+func ExampleNewNonEmpty() {
+	numbers := types.NewNonEmpty(1, 2, 3, 4)
+
+	sum := numbers.Reduce(func(agg, item int) int {
+		return agg + item
+	})
+
+	fmt.Println(sum, numbers.Len())
+	fmt.Println(seq.Collect(seq.Map(numbers.All(), func(n int) int { return n * n })))
+	// Output:
+	// 10 4
+	// [1 4 9 16]
+}
+
+func ExampleNonEmpty_UnmarshalJSON() {
+	var request struct {
+		IDs types.NonEmpty[int] `json:"ids"`
+	}
+
+	err := json.Unmarshal([]byte(`{"ids":[1,2]}`), &request)
+	fmt.Println(request.IDs.ToSlice(), err)
+
+	err = json.Unmarshal([]byte(`{"ids":[]}`), &request)
+	fmt.Println(err)
+	// Output:
+	// [1 2] <nil>
+	// non-empty collection cannot be empty
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func TestNonEmptyZeroValue(t *testing.T) {
+	// given:
+	var zero types.NonEmpty[int]
+
+	// then:
+	assert.Equal(t, 0, zero.Len())
+	assert.Empty(t, zero.ToSlice())
+	assert.Nil(t, zero.Tail())
+	assert.Panics(t, func() { zero.Head() })
+	assert.Panics(t, func() { zero.Last() })
+	assert.Panics(t, func() { zero.Reduce(func(a, b int) int { return a + b }) })
+	assert.Panics(t, func() { zero.MaxFunc(cmp.Compare[int]) })
+	assert.Panics(t, func() { zero.MinFunc(cmp.Compare[int]) })
+	assert.Panics(t, func() { types.NonEmptyMax(zero) })
+	assert.Panics(t, func() { types.NonEmptyMin(zero) })
+}
+
+func TestNonEmptySingleElement(t *testing.T) {
+	// given:
+	single := types.NewNonEmpty(42)
+
+	// then:
+	assert.Equal(t, 1, single.Len())
+	assert.Equal(t, 42, single.Head())
+	assert.Equal(t, 42, single.Last())
+	assert.Empty(t, single.Tail())
+	assert.Equal(t, 42, single.Reduce(func(a, b int) int { return a + b }))
+	assert.Equal(t, 42, single.MaxFunc(cmp.Compare[int]))
+	assert.Equal(t, 42, single.MinFunc(cmp.Compare[int]))
+	assert.Equal(t, 42, types.NonEmptyMax(single))
+	assert.Equal(t, 42, types.NonEmptyMin(single))
+}
+
+func TestNonEmptyFromCopiesSlice(t *testing.T) {
+	// given:
+	slice := []int{1, 2, 3}
+
+	// when:
+	nonEmpty, ok := types.NonEmptyFrom(slice)
+	slice[0] = 100
+
+	// then:
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 2, 3}, nonEmpty.ToSlice())
+}
+
+func TestNonEmptyFromEmptySlice(t *testing.T) {
+	// when:
+	nonEmpty, ok := types.NonEmptyFrom([]int{})
+
+	// then:
+	assert.False(t, ok)
+	assert.Equal(t, 0, nonEmpty.Len())
+}
@@ -0,0 +1,334 @@
+package types
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap is a map that preserves the insertion order of its keys.
+//
+// Get, Set and Delete operations are O(1). Setting a value for an existing key doesn't change its position.
+// The zero value is an empty map ready to use.
+//
+// OrderedMap is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*orderedMapEntry[K, V]
+	head    *orderedMapEntry[K, V]
+	tail    *orderedMapEntry[K, V]
+}
+
+type orderedMapEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedMapEntry[K, V]
+	next  *orderedMapEntry[K, V]
+}
+
+// NewOrderedMap creates a new empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+// OrderedMapFrom creates a new OrderedMap with the key-value pairs of the given sequence, in the order of the sequence.
+// If the key occurs multiple times, the last value wins, but the key keeps the position of its first occurrence.
+func OrderedMapFrom[K comparable, V any](seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	m := NewOrderedMap[K, V]()
+	for k, v := range seq {
+		m.Set(k, v)
+	}
+	return m
+}
+
+// Set sets the value for the key.
+// If the key is new, it is added at the end of the map, otherwise its position is preserved.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if entry, ok := m.entries[key]; ok {
+		entry.value = value
+		return
+	}
+
+	if m.entries == nil {
+		m.entries = make(map[K]*orderedMapEntry[K, V])
+	}
+
+	entry := &orderedMapEntry[K, V]{key: key, value: value, prev: m.tail}
+	if m.tail == nil {
+		m.head = entry
+	} else {
+		m.tail.next = entry
+	}
+	m.tail = entry
+	m.entries[key] = entry
+}
+
+// Get returns the value for the key and true if the key is present,
+// otherwise it returns zero value and false.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	entry, ok := m.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// GetOrElse returns the value for the key if present, otherwise returns the default value.
+func (m *OrderedMap[K, V]) GetOrElse(key K, defaultValue V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// Contains returns true if the key is present in the map.
+func (m *OrderedMap[K, V]) Contains(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Delete removes the key from the map.
+// Returns true if the key was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	if entry.prev == nil {
+		m.head = entry.next
+	} else {
+		entry.prev.next = entry.next
+	}
+	if entry.next == nil {
+		m.tail = entry.prev
+	} else {
+		entry.next.prev = entry.prev
+	}
+	delete(m.entries, key)
+	return true
+}
+
+// Len returns the number of keys in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// IsEmpty returns true if the map has no keys.
+func (m *OrderedMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+// Clear removes all keys from the map.
+func (m *OrderedMap[K, V]) Clear() {
+	m.entries = nil
+	m.head = nil
+	m.tail = nil
+}
+
+// Clone returns a shallow copy of the map, preserving the order of keys.
+func (m *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	return OrderedMapFrom(m.All())
+}
+
+// All returns an iter.Seq2 over the key-value pairs of the map in insertion order.
+// It is safe to delete any keys during the iteration, the deleted keys are not yielded afterwards.
+//
+// This is useful for reusing functions provided by package seq2.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := m.head; entry != nil; entry = m.advance(entry, false) {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iter.Seq2 over the key-value pairs of the map in reverse insertion order.
+// It is safe to delete any keys during the iteration, the deleted keys are not yielded afterwards.
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for entry := m.tail; entry != nil; entry = m.advance(entry, true) {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// advance returns the entry after the given one (or before it, if backward is true), skipping the deleted entries.
+// The deleted entries keep their links, so the iteration can continue even if the given entry has been deleted.
+func (m *OrderedMap[K, V]) advance(entry *orderedMapEntry[K, V], backward bool) *orderedMapEntry[K, V] {
+	for {
+		if backward {
+			entry = entry.prev
+		} else {
+			entry = entry.next
+		}
+		if entry == nil || m.entries[entry.key] == entry {
+			return entry
+		}
+	}
+}
+
+// Keys returns an iter.Seq over the keys of the map in insertion order.
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iter.Seq over the values of the map in insertion order of their keys.
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// The map is marshaled as a JSON object with keys in insertion order.
+// Keys must be strings, integers or implement encoding.TextMarshaler (as for the regular maps in encoding/json).
+// It has a value receiver, so the map is marshaled properly also when it's used as a non-pointer field.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	i := 0
+	for k, v := range m.All() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		i++
+
+		key, err := marshalMapKey(k)
+		if err != nil {
+			return nil, err
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal key %v: %w", k, err)
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+
+		encodedValue, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal value for key %v: %w", k, err)
+		}
+		buf.Write(encodedValue)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// The keys of the JSON object are added in the order they appear in the input.
+// Like for the regular maps in encoding/json, the entries are added to the existing entries of the map.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal ordered map: %w", err)
+	}
+	if token == nil {
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("failed to unmarshal ordered map: expected JSON object, got %v", token)
+	}
+
+	for dec.More() {
+		token, err = dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal ordered map: %w", err)
+		}
+
+		key, err := unmarshalMapKey[K](token.(string))
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return fmt.Errorf("failed to unmarshal value for key %v: %w", key, err)
+		}
+		m.Set(key, value)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("failed to unmarshal ordered map: %w", err)
+	}
+	return nil
+}
+
+var errUnsupportedMapKey = errors.New("unsupported map key type")
+
+func marshalMapKey(key any) (string, error) {
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal key %v: %w", key, err)
+		}
+		return string(text), nil
+	}
+
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("%w: %T", errUnsupportedMapKey, key)
+	}
+}
+
+func unmarshalMapKey[K comparable](key string) (K, error) {
+	var result K
+
+	if tu, ok := any(&result).(encoding.TextUnmarshaler); ok {
+		if err := tu.UnmarshalText([]byte(key)); err != nil {
+			return result, fmt.Errorf("failed to unmarshal key %q: %w", key, err)
+		}
+		return result, nil
+	}
+
+	v := reflect.ValueOf(&result).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(key, 10, v.Type().Bits())
+		if err != nil {
+			return result, fmt.Errorf("failed to unmarshal key %q: %w", key, err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(key, 10, v.Type().Bits())
+		if err != nil {
+			return result, fmt.Errorf("failed to unmarshal key %q: %w", key, err)
+		}
+		v.SetUint(n)
+	default:
+		return result, fmt.Errorf("%w: %T", errUnsupportedMapKey, result)
+	}
+	return result, nil
+}
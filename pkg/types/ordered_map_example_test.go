@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/seq2"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleNewOrderedMap() {
+	m := types.NewOrderedMap[string, int]()
+
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	// updating the value doesn't change the position of the key
+	m.Set("c", 30)
+
+	for k, v := range m.All() {
+		fmt.Println(k, v)
+	}
+
+	// Output:
+	// c 30
+	// a 1
+	// b 2
+}
+
+func ExampleOrderedMap_Get() {
+	m := types.NewOrderedMap[string, int]()
+	m.Set("a", 1)
+
+	value, ok := m.Get("a")
+	fmt.Println(value, ok)
+
+	value, ok = m.Get("b")
+	fmt.Println(value, ok)
+
+	fmt.Println(m.GetOrElse("b", -1))
+
+	// Output:
+	// 1 true
+	// 0 false
+	// -1
+}
+
+func ExampleOrderedMap_Delete() {
+	m := types.OrderedMapFrom(seq2.FromSlice([]string{"a", "b", "c"}))
+
+	m.Delete(1)
+	m.Set(1, "d")
+
+	fmt.Println(seq.Collect(m.Values()))
+	fmt.Println(seq.Collect(m.Keys()))
+
+	// Output:
+	// [a c d]
+	// [0 2 1]
+}
+
+func ExampleOrderedMap_Backward() {
+	m := types.OrderedMapFrom(seq2.FromSlice([]string{"a", "b", "c"}))
+
+	for k, v := range m.Backward() {
+		fmt.Println(k, v)
+	}
+
+	// Output:
+	// 2 c
+	// 1 b
+	// 0 a
+}
+
+func ExampleOrderedMap_MarshalJSON() {
+	type Config struct {
+		Steps types.OrderedMap[string, int] `json:"steps"`
+	}
+
+	var cfg Config
+	_ = json.Unmarshal([]byte(`{"steps":{"build":1,"test":2,"deploy":3}}`), &cfg)
+
+	fmt.Println(seq.Collect(cfg.Steps.Keys()))
+
+	cfg.Steps.Set("notify", 4)
+	data, _ := json.Marshal(cfg)
+	fmt.Println(string(data))
+
+	// Output:
+	// [build test deploy]
+	// {"steps":{"build":1,"test":2,"deploy":3,"notify":4}}
+}
@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func TestOrderedMapDeletingDuringIteration(t *testing.T) {
+	t.Run("forward", func(t *testing.T) {
+		// given:
+		m := types.NewOrderedMap[string, int]()
+		for i, key := range []string{"a", "b", "c", "d", "e"} {
+			m.Set(key, i)
+		}
+
+		// when:
+		var yielded []string
+		for key := range m.All() {
+			yielded = append(yielded, key)
+			switch key {
+			case "a":
+				m.Delete("a")
+				m.Delete("b")
+			case "c":
+				m.Delete("d")
+			}
+		}
+
+		// then:
+		assert.Equal(t, []string{"a", "c", "e"}, yielded)
+		assert.Equal(t, []string{"c", "e"}, seq.Collect(m.Keys()))
+	})
+
+	t.Run("backward", func(t *testing.T) {
+		// given:
+		m := types.NewOrderedMap[string, int]()
+		for i, key := range []string{"a", "b", "c", "d", "e"} {
+			m.Set(key, i)
+		}
+
+		// when:
+		var yielded []string
+		for key := range m.Backward() {
+			yielded = append(yielded, key)
+			if key == "e" {
+				m.Delete("e")
+				m.Delete("d")
+			}
+		}
+
+		// then:
+		assert.Equal(t, []string{"e", "c", "b", "a"}, yielded)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		// given:
+		m := types.NewOrderedMap[string, int]()
+		m.Set("a", 1)
+		m.Set("b", 2)
+
+		// when:
+		var yielded []string
+		for key := range m.All() {
+			yielded = append(yielded, key)
+			m.Clear()
+		}
+
+		// then:
+		assert.Equal(t, []string{"a"}, yielded)
+	})
+}
+
+func TestOrderedMapJSONRoundTrip(t *testing.T) {
+	// given:
+	original := types.NewOrderedMap[int, []string]()
+	original.Set(3, []string{"c"})
+	original.Set(1, []string{"a", "b"})
+	original.Set(2, nil)
+
+	// when:
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+
+	var decoded types.OrderedMap[int, []string]
+	err = json.Unmarshal(data, &decoded)
+
+	// then:
+	require.NoError(t, err)
+	assert.Equal(t, `{"3":["c"],"1":["a","b"],"2":null}`, string(data))
+	assert.Equal(t, []int{3, 1, 2}, seq.Collect(decoded.Keys()))
+	assert.Equal(t, [][]string{{"c"}, {"a", "b"}, nil}, seq.Collect(decoded.Values()))
+}
@@ -6,7 +6,8 @@
 // define sets of types usable with type parameters, such as numeric types,
 // ordered types, or comparable types.
 //
-// It also includes utility types and structs like tuples or pairs, which simplify working with grouped data.
+// It also includes utility types and structs like tuples, pairs, either, sets, ordered maps, non-empty collections or lazy values, which simplify working with grouped data,
+// and containers like heaps (priority queues), ring buffers or deques.
 //
 // The `types` package is designed to complement Go's type parameter features,
 // making it easier to write reusable and type-safe code.
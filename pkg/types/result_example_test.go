@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleMapResult() {
+	success := types.MapResult(types.SuccessResult(21), func(v int) int {
+		return v * 2
+	})
+	fmt.Println(success.Get())
+
+	failure := types.MapResult(types.FailureResult[int](errors.New("failed")), func(v int) int {
+		return v * 2
+	})
+	fmt.Println(failure.Get())
+
+	// Output:
+	// 42 <nil>
+	// 0 failed
+}
+
+func ExampleFlatMapResult() {
+	parse := func(s string) *types.Result[int] {
+		return types.ResultOf(strconv.Atoi(s))
+	}
+
+	parsed := types.FlatMapResult(types.SuccessResult("42"), parse)
+	fmt.Println(parsed.Get())
+
+	invalid := types.FlatMapResult(types.SuccessResult("abc"), parse)
+	fmt.Println(invalid.IsError())
+
+	// Output:
+	// 42 <nil>
+	// true
+}
+
+func ExampleResult_MapError() {
+	result := types.FailureResult[int](errors.New("connection refused")).
+		MapError(func(err error) error {
+			return fmt.Errorf("failed to fetch user: %w", err)
+		})
+
+	fmt.Println(result.GetError())
+
+	// Output:
+	// failed to fetch user: connection refused
+}
+
+func ExampleResult_Recover() {
+	result := types.FailureResult[string](errors.New("not found")).
+		Recover(func(err error) string {
+			return "default"
+		})
+
+	fmt.Println(result.Get())
+
+	// Output:
+	// default <nil>
+}
+
+func ExampleResultAll() {
+	all := types.ResultAll([]*types.Result[int]{
+		types.SuccessResult(1),
+		types.SuccessResult(2),
+	})
+	fmt.Println(all.Get())
+
+	withErrors := types.ResultAll([]*types.Result[int]{
+		types.SuccessResult(1),
+		types.FailureResult[int](errors.New("first")),
+		types.FailureResult[int](errors.New("second")),
+	})
+	fmt.Println(withErrors.GetError())
+
+	// Output:
+	// [1 2] <nil>
+	// first
+	// second
+}
+
+func ExampleTry() {
+	success := types.Try(func() int {
+		return 42
+	})
+	fmt.Println(success.Get())
+
+	failure := types.Try(func() int {
+		var m map[string]int
+		m["boom"] = 1
+		return 0
+	})
+	fmt.Println(failure.GetError())
+
+	// Output:
+	// 42 <nil>
+	// recovered from panic: assignment to entry in nil map
+}
+
+func ExampleResult_MarshalJSON() {
+	results := []*types.Result[int]{
+		types.SuccessResult(42),
+		types.FailureResult[int](errors.New("invalid input")),
+	}
+
+	data, _ := json.Marshal(results)
+	fmt.Println(string(data))
+
+	var decoded []*types.Result[int]
+	_ = json.Unmarshal(data, &decoded)
+	for _, r := range decoded {
+		fmt.Println(r.Get())
+	}
+
+	// Output:
+	// [{"value":42},{"error":"invalid input"}]
+	// 42 <nil>
+	// 0 invalid input
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MapResult maps the value of the result if there is no error, otherwise it returns a failure with the same error.
+func MapResult[V, R any](result *Result[V], mapper func(V) R) *Result[R] {
+	if result.IsError() {
+		return FailureResult[R](result.err)
+	}
+	return SuccessResult(mapper(result.value))
+}
+
+// FlatMapResult maps the value of the result to another Result if there is no error,
+// otherwise it returns a failure with the same error.
+func FlatMapResult[V, R any](result *Result[V], mapper func(V) *Result[R]) *Result[R] {
+	if result.IsError() {
+		return FailureResult[R](result.err)
+	}
+	return mapper(result.value)
+}
+
+// ResultAll collects values of all results into a slice.
+// If any of the results is an error, it returns a failure with all the errors joined (see errors.Join).
+func ResultAll[V any](results []*Result[V]) *Result[[]V] {
+	values := make([]V, 0, len(results))
+	var errs []error
+	for _, r := range results {
+		if r.IsError() {
+			errs = append(errs, r.err)
+			continue
+		}
+		values = append(values, r.value)
+	}
+
+	if len(errs) > 0 {
+		return FailureResult[[]V](errors.Join(errs...))
+	}
+	return SuccessResult(values)
+}
+
+// Try calls the provided function and returns its value as a successful Result.
+// If the function panics, the panic is recovered and returned as a failure.
+// If the panic value is an error, it is wrapped, so it can be checked with errors.Is and errors.As.
+func Try[V any](fn func() V) (result *Result[V]) {
+	defer func() {
+		if r := recover(); r != nil {
+			if err, ok := r.(error); ok {
+				result = FailureResult[V](fmt.Errorf("recovered from panic: %w", err))
+			} else {
+				result = FailureResult[V](fmt.Errorf("recovered from panic: %v", r))
+			}
+		}
+	}()
+
+	return SuccessResult(fn())
+}
+
+// MapError maps the error of the result if there is an error, otherwise it returns this Result.
+func (m *Result[V]) MapError(mapper func(error) error) *Result[V] {
+	if m.IsError() {
+		return FailureResult[V](mapper(m.err))
+	}
+	return m
+}
+
+// Recover returns a successful Result with the value returned by the provided function if there is an error,
+// otherwise it returns this Result.
+func (m *Result[V]) Recover(recovery func(error) V) *Result[V] {
+	if m.IsError() {
+		return SuccessResult(recovery(m.err))
+	}
+	return m
+}
+
+type resultJSON[V any] struct {
+	Value *V      `json:"value,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// Successful result is marshaled as {"value": ...}, failure is marshaled as {"error": "error message"}.
+// It has a value receiver, so the result is marshaled properly also when it's used as a non-pointer field.
+func (m Result[V]) MarshalJSON() ([]byte, error) {
+	if m.IsError() {
+		msg := m.err.Error()
+		return json.Marshal(resultJSON[V]{Error: &msg}) //nolint:wrapcheck
+	}
+	return json.Marshal(resultJSON[V]{Value: &m.value}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// Object with "error" key is unmarshaled as a failure with an error with the given message,
+// otherwise it is unmarshaled as a successful result with the value from "value" key.
+func (m *Result[V]) UnmarshalJSON(data []byte) error {
+	var decoded resultJSON[V]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to unmarshal result: %w", err)
+	}
+
+	if decoded.Error != nil {
+		*m = Result[V]{err: errors.New(*decoded.Error)}
+		return nil
+	}
+
+	var value V
+	if decoded.Value != nil {
+		value = *decoded.Value
+	}
+	*m = Result[V]{value: value}
+	return nil
+}
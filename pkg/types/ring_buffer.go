@@ -0,0 +1,144 @@
+package types
+
+import "iter"
+
+// RingBuffer is a fixed capacity buffer which keeps the most recently pushed elements.
+// When it is full, pushing a new element overwrites the oldest one.
+//
+// It is useful for keeping the last N events, or as a sliding window over a sequence.
+// Push and access by index are O(1) and it never allocates after creation.
+//
+// Create it with NewRingBuffer, the zero value of RingBuffer has no capacity and Push panics on it.
+//
+// RingBuffer is not safe for concurrent use.
+type RingBuffer[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// NewRingBuffer creates a new empty RingBuffer with the given capacity.
+// It panics if capacity is not positive.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("types: RingBuffer capacity must be positive")
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity)}
+}
+
+// Push adds the element as the newest one.
+// If the buffer was full, it returns the overwritten oldest element and true,
+// otherwise it returns zero value and false.
+// It panics if the buffer wasn't created with NewRingBuffer.
+func (r *RingBuffer[T]) Push(elem T) (overwritten T, ok bool) {
+	if len(r.buf) == 0 {
+		panic("types: RingBuffer must be created with NewRingBuffer")
+	}
+	if r.size < len(r.buf) {
+		r.buf[r.index(r.size)] = elem
+		r.size++
+		return overwritten, false
+	}
+
+	overwritten = r.buf[r.head]
+	r.buf[r.head] = elem
+	r.head = r.index(1)
+	return overwritten, true
+}
+
+// PopOldest removes and returns the oldest element and true,
+// or zero value and false if the buffer is empty.
+func (r *RingBuffer[T]) PopOldest() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+
+	elem := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = r.index(1)
+	r.size--
+	return elem, true
+}
+
+// Oldest returns the oldest element and true, or zero value and false if the buffer is empty.
+func (r *RingBuffer[T]) Oldest() (T, bool) {
+	return r.Get(0)
+}
+
+// Newest returns the most recently pushed element and true, or zero value and false if the buffer is empty.
+func (r *RingBuffer[T]) Newest() (T, bool) {
+	return r.Get(r.size - 1)
+}
+
+// Get returns the element at index i, counting from the oldest element, and true,
+// or zero value and false if the index is out of range.
+func (r *RingBuffer[T]) Get(i int) (T, bool) {
+	if i < 0 || i >= r.size {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.index(i)], true
+}
+
+// Len returns the number of elements in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Capacity returns the maximum number of elements kept in the buffer.
+func (r *RingBuffer[T]) Capacity() int {
+	return len(r.buf)
+}
+
+// IsEmpty returns true if the buffer has no elements.
+func (r *RingBuffer[T]) IsEmpty() bool {
+	return r.size == 0
+}
+
+// IsFull returns true if the buffer holds capacity elements, so the next Push overwrites the oldest element.
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.size == len(r.buf)
+}
+
+// Clear removes all elements from the buffer.
+func (r *RingBuffer[T]) Clear() {
+	clear(r.buf)
+	r.head = 0
+	r.size = 0
+}
+
+// All returns an iter.Seq over the elements of the buffer, from the oldest to the newest.
+//
+// This is useful for reusing functions provided by package seq.
+func (r *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.size; i++ {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iter.Seq over the elements of the buffer, from the newest to the oldest.
+func (r *RingBuffer[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := r.size - 1; i >= 0; i-- {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the elements of the buffer as a slice, from the oldest to the newest.
+func (r *RingBuffer[T]) ToSlice() []T {
+	result := make([]T, r.size)
+	copyCircular(result, r.buf, r.head, r.size)
+	return result
+}
+
+func (r *RingBuffer[T]) index(i int) int {
+	return (r.head + i) % len(r.buf)
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleNewRingBuffer() {
+	lastEvents := types.NewRingBuffer[string](3)
+
+	for _, event := range []string{"started", "connected", "request", "response", "disconnected"} {
+		lastEvents.Push(event)
+	}
+
+	fmt.Println(seq.Collect(lastEvents.All()))
+	fmt.Println(seq.Collect(lastEvents.Backward()))
+	// Output:
+	// [request response disconnected]
+	// [disconnected response request]
+}
+
+func ExampleRingBuffer_Push() {
+	window := types.NewRingBuffer[int](2)
+
+	fmt.Println(window.Push(1))
+	fmt.Println(window.Push(2))
+	fmt.Println(window.Push(3))
+	// Output:
+	// 0 false
+	// 0 false
+	// 1 true
+}
+
+func ExampleRingBuffer_Get() {
+	buffer := types.NewRingBuffer[int](3)
+	for i := range 5 {
+		buffer.Push(i)
+	}
+
+	fmt.Println(buffer.Get(0))
+	fmt.Println(buffer.Newest())
+	fmt.Println(buffer.Get(3))
+	// Output:
+	// 2 true
+	// 4 true
+	// 0 false
+}
+
+func ExampleRingBuffer_slidingWindow() {
+	window := types.NewRingBuffer[int](3)
+
+	for _, v := range []int{1, 2, 3, 4, 5} {
+		window.Push(v)
+		if window.IsFull() {
+			sum := seq.Reduce(window.All(), func(agg, v int) int { return agg + v }, 0)
+			fmt.Println(sum)
+		}
+	}
+	// Output:
+	// 6
+	// 9
+	// 12
+}
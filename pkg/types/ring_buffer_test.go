@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func TestRingBufferToSliceAfterWrapAround(t *testing.T) {
+	buffer := types.NewRingBuffer[int](4)
+	for i := range 10 {
+		buffer.Push(i)
+	}
+
+	assert.Equal(t, []int{6, 7, 8, 9}, buffer.ToSlice())
+
+	buffer.PopOldest()
+	buffer.Push(10)
+	buffer.Push(11)
+
+	assert.Equal(t, []int{8, 9, 10, 11}, buffer.ToSlice())
+}
+
+func TestRingBufferZeroValue(t *testing.T) {
+	var buffer types.RingBuffer[int]
+
+	assert.Equal(t, 0, buffer.Capacity())
+	assert.True(t, buffer.IsEmpty())
+	assert.Empty(t, buffer.ToSlice())
+	_, ok := buffer.PopOldest()
+	assert.False(t, ok)
+	assert.PanicsWithValue(t, "types: RingBuffer must be created with NewRingBuffer", func() { buffer.Push(1) })
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Set is a generic collection of unique elements.
+//
+// It is a map[T]struct{} under the hood, so it can be converted from and to such maps without copying,
+// and it can be used with the built-in len, range and delete.
+// The zero value (nil Set) is an empty set that can be read, but not modified, use NewSet to create a modifiable set.
+//
+// Set is not safe for concurrent use, see ConcurrentSet for a thread-safe variant.
+type Set[T comparable] map[T]struct{}
+
+// NewSet creates a new Set with the given elements.
+func NewSet[T comparable](elems ...T) Set[T] {
+	return SetFromSlice(elems)
+}
+
+// SetFromSlice creates a new Set with the elements of the given slice.
+func SetFromSlice[T comparable](slice []T) Set[T] {
+	set := make(Set[T], len(slice))
+	for _, e := range slice {
+		set[e] = struct{}{}
+	}
+	return set
+}
+
+// SetFrom creates a new Set with the elements of the given sequence.
+func SetFrom[T comparable](seq iter.Seq[T]) Set[T] {
+	set := make(Set[T])
+	for e := range seq {
+		set[e] = struct{}{}
+	}
+	return set
+}
+
+// Add adds the given elements to the set.
+func (s Set[T]) Add(elems ...T) {
+	for _, e := range elems {
+		s[e] = struct{}{}
+	}
+}
+
+// Remove removes the given elements from the set.
+func (s Set[T]) Remove(elems ...T) {
+	for _, e := range elems {
+		delete(s, e)
+	}
+}
+
+// Contains returns true if the element is in the set.
+func (s Set[T]) Contains(elem T) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// ContainsAll returns true if all the given elements are in the set.
+func (s Set[T]) ContainsAll(elems ...T) bool {
+	for _, e := range elems {
+		if !s.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of elements in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// IsEmpty returns true if the set has no elements.
+func (s Set[T]) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Clone returns a copy of the set.
+func (s Set[T]) Clone() Set[T] {
+	result := make(Set[T], len(s))
+	maps.Copy(result, s)
+	return result
+}
+
+// Union returns a new set with elements that are in this set or in the other set.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := make(Set[T], max(len(s), len(other)))
+	maps.Copy(result, s)
+	maps.Copy(result, other)
+	return result
+}
+
+// Intersection returns a new set with elements that are in both this set and the other set.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	smaller, bigger := s, other
+	if len(smaller) > len(bigger) {
+		smaller, bigger = bigger, smaller
+	}
+
+	result := make(Set[T], len(smaller))
+	for e := range smaller {
+		if bigger.Contains(e) {
+			result[e] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with elements that are in this set but not in the other set.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T], len(s))
+	for e := range s {
+		if !other.Contains(e) {
+			result[e] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset returns true if all elements of this set are in the other set.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for e := range s {
+		if !other.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if all elements of the other set are in this set.
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true if both sets contain the same elements.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// All returns an iter.Seq over the elements of the set.
+// The iteration order is not specified.
+//
+// This is useful for reusing functions provided by package seq.
+func (s Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
+
+// ToSlice returns the elements of the set as a slice.
+// The order of elements is not specified.
+func (s Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s))
+	for e := range s {
+		result = append(result, e)
+	}
+	return result
+}
+
+// SortedFunc returns the elements of the set as a slice sorted using the cmp function.
+// For sets of ordered types use SortedSetValues.
+func (s Set[T]) SortedFunc(cmp func(a, b T) int) []T {
+	return slices.SortedFunc(s.All(), cmp)
+}
+
+// SortedSetValues returns the elements of the set as a slice sorted in ascending order.
+func SortedSetValues[T Ordered](s Set[T]) []T {
+	return slices.Sorted(s.All())
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// The set is marshaled as a JSON array, the elements are ordered by their JSON representation,
+// so the output is deterministic.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	encoded := make([][]byte, 0, len(s))
+	for e := range s {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set element: %w", err)
+		}
+		encoded = append(encoded, b)
+	}
+	slices.SortFunc(encoded, bytes.Compare)
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.Write(bytes.Join(encoded, []byte{','}))
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// The set is unmarshaled from a JSON array, duplicated elements are merged.
+// Elements are added to the existing elements of the set.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return fmt.Errorf("failed to unmarshal set: %w", err)
+	}
+
+	if *s == nil {
+		*s = make(Set[T], len(elems))
+	}
+	s.Add(elems...)
+	return nil
+}
@@ -0,0 +1,149 @@
+package types_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleNewSet() {
+	set := types.NewSet("a", "b", "a")
+
+	fmt.Println(set.Len())
+	fmt.Println(set.Contains("a"))
+	fmt.Println(set.Contains("c"))
+
+	// Output:
+	// 2
+	// true
+	// false
+}
+
+func ExampleSetFrom() {
+	set := types.SetFrom(seq.Range(0, 5))
+
+	fmt.Println(types.SortedSetValues(set))
+
+	// Output:
+	// [0 1 2 3 4]
+}
+
+func ExampleSet_conversion() {
+	existing := map[string]struct{}{"a": {}, "b": {}}
+
+	set := types.Set[string](existing)
+	set.Add("c")
+
+	fmt.Println(len(existing))
+
+	// Output:
+	// 3
+}
+
+func ExampleSet_Add() {
+	set := types.NewSet[int]()
+
+	set.Add(3, 1, 2)
+	set.Remove(2)
+
+	fmt.Println(types.SortedSetValues(set))
+
+	// Output:
+	// [1 3]
+}
+
+func ExampleSet_Union() {
+	a := types.NewSet(1, 2, 3)
+	b := types.NewSet(3, 4)
+
+	fmt.Println(types.SortedSetValues(a.Union(b)))
+	fmt.Println(types.SortedSetValues(a.Intersection(b)))
+	fmt.Println(types.SortedSetValues(a.Difference(b)))
+
+	// Output:
+	// [1 2 3 4]
+	// [3]
+	// [1 2]
+}
+
+func ExampleSet_IsSubset() {
+	a := types.NewSet(1, 2)
+	b := types.NewSet(1, 2, 3)
+
+	fmt.Println(a.IsSubset(b))
+	fmt.Println(b.IsSubset(a))
+	fmt.Println(b.IsSuperset(a))
+
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func ExampleSet_All() {
+	set := types.NewSet(1, 2, 3, 4)
+
+	even := seq.Filter(set.All(), func(v int) bool {
+		return v%2 == 0
+	})
+
+	fmt.Println(seq.Collect(seq.Sort(even)))
+
+	// Output:
+	// [2 4]
+}
+
+func ExampleSet_SortedFunc() {
+	set := types.NewSet("b", "C", "a")
+
+	sorted := set.SortedFunc(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	fmt.Println(sorted)
+
+	// Output:
+	// [a b C]
+}
+
+func ExampleSet_MarshalJSON() {
+	type Document struct {
+		Tags types.Set[string] `json:"tags"`
+	}
+
+	data, _ := json.Marshal(Document{Tags: types.NewSet("go", "json", "api")})
+	fmt.Println(string(data))
+
+	var doc Document
+	_ = json.Unmarshal([]byte(`{"tags":["x","y","x"]}`), &doc)
+	fmt.Println(types.SortedSetValues(doc.Tags))
+
+	// Output:
+	// {"tags":["api","go","json"]}
+	// [x y]
+}
+
+func ExampleConcurrentSet() {
+	set := types.NewConcurrentSet[int]()
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			set.Add(i % 5)
+		}()
+	}
+	wg.Wait()
+
+	fmt.Println(set.Len())
+	fmt.Println(types.SortedSetValues(set.Snapshot()))
+
+	// Output:
+	// 5
+	// [0 1 2 3 4]
+}
@@ -1,5 +1,7 @@
 package types
 
+import "iter"
+
 // Tuple is a group of 2 elements
 type Tuple[A, B any] = Tuple2[A, B]
 
@@ -19,6 +21,25 @@ func NewTuple2[A, B any](a A, b B) Tuple2[A, B] {
 	return Tuple2[A, B]{A: a, B: b}
 }
 
+// Unpack returns all values of the tuple.
+func (t Tuple2[A, B]) Unpack() (A, B) {
+	return t.A, t.B
+}
+
+// ToPair converts the Tuple2 to a Pair.
+func (t Tuple2[A, B]) ToPair() Pair[A, B] {
+	return Pair[A, B]{Left: t.A, Right: t.B}
+}
+
+// Seq2 returns an iter.Seq2 with both values of the tuple.
+//
+// This is useful for reusing functions provided by package seq2.
+func (t Tuple2[A, B]) Seq2() iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		yield(t.A, t.B)
+	}
+}
+
 // GetLeft returns the left value of the tuple.
 func (t Tuple2[A, B]) GetLeft() A {
 	return t.A
@@ -41,6 +62,11 @@ func NewTuple3[A, B, C any](a A, b B, c C) Tuple3[A, B, C] {
 	return Tuple3[A, B, C]{A: a, B: b, C: c}
 }
 
+// Unpack returns all values of the tuple.
+func (t Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return t.A, t.B, t.C
+}
+
 // Tuple4 is a group of 4 elements.
 type Tuple4[A, B, C, D any] struct {
 	A A
@@ -54,6 +80,11 @@ func NewTuple4[A, B, C, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
 	return Tuple4[A, B, C, D]{A: a, B: b, C: c, D: d}
 }
 
+// Unpack returns all values of the tuple.
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.A, t.B, t.C, t.D
+}
+
 // Tuple5 is a group of 5 elements.
 type Tuple5[A, B, C, D, E any] struct {
 	A A
@@ -68,6 +99,11 @@ func NewTuple5[A, B, C, D, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E]
 	return Tuple5[A, B, C, D, E]{A: a, B: b, C: c, D: d, E: e}
 }
 
+// Unpack returns all values of the tuple.
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.A, t.B, t.C, t.D, t.E
+}
+
 // Tuple6 is a group of 6 elements.
 type Tuple6[A, B, C, D, E, F any] struct {
 	A A
@@ -83,6 +119,11 @@ func NewTuple6[A, B, C, D, E, F any](a A, b B, c C, d D, e E, f F) Tuple6[A, B,
 	return Tuple6[A, B, C, D, E, F]{A: a, B: b, C: c, D: d, E: e, F: f}
 }
 
+// Unpack returns all values of the tuple.
+func (t Tuple6[A, B, C, D, E, F]) Unpack() (A, B, C, D, E, F) {
+	return t.A, t.B, t.C, t.D, t.E, t.F
+}
+
 // Tuple7 is a group of 7 elements.
 type Tuple7[A, B, C, D, E, F, G any] struct {
 	A A
@@ -99,6 +140,11 @@ func NewTuple7[A, B, C, D, E, F, G any](a A, b B, c C, d D, e E, f F, g G) Tuple
 	return Tuple7[A, B, C, D, E, F, G]{A: a, B: b, C: c, D: d, E: e, F: f, G: g}
 }
 
+// Unpack returns all values of the tuple.
+func (t Tuple7[A, B, C, D, E, F, G]) Unpack() (A, B, C, D, E, F, G) {
+	return t.A, t.B, t.C, t.D, t.E, t.F, t.G
+}
+
 // Tuple8 is a group of 8 elements.
 type Tuple8[A, B, C, D, E, F, G, H any] struct {
 	A A
@@ -116,6 +162,11 @@ func NewTuple8[A, B, C, D, E, F, G, H any](a A, b B, c C, d D, e E, f F, g G, h
 	return Tuple8[A, B, C, D, E, F, G, H]{A: a, B: b, C: c, D: d, E: e, F: f, G: g, H: h}
 }
 
+// Unpack returns all values of the tuple.
+func (t Tuple8[A, B, C, D, E, F, G, H]) Unpack() (A, B, C, D, E, F, G, H) {
+	return t.A, t.B, t.C, t.D, t.E, t.F, t.G, t.H
+}
+
 // Tuple9 is a group of 9 elements.
 type Tuple9[A, B, C, D, E, F, G, H, I any] struct {
 	A A
@@ -133,3 +184,8 @@ type Tuple9[A, B, C, D, E, F, G, H, I any] struct {
 func NewTuple9[A, B, C, D, E, F, G, H, I any](a A, b B, c C, d D, e E, f F, g G, h H, i I) Tuple9[A, B, C, D, E, F, G, H, I] {
 	return Tuple9[A, B, C, D, E, F, G, H, I]{A: a, B: b, C: c, D: d, E: e, F: f, G: g, H: h, I: i}
 }
+
+// Unpack returns all values of the tuple.
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) Unpack() (A, B, C, D, E, F, G, H, I) {
+	return t.A, t.B, t.C, t.D, t.E, t.F, t.G, t.H, t.I
+}
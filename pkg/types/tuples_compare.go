@@ -0,0 +1,111 @@
+package types
+
+import "cmp"
+
+// CompareTuple2 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple2[A, B Ordered](x, y Tuple2[A, B]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+	)
+}
+
+// CompareTuple3 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple3[A, B, C Ordered](x, y Tuple3[A, B, C]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+		cmp.Compare(x.C, y.C),
+	)
+}
+
+// CompareTuple4 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple4[A, B, C, D Ordered](x, y Tuple4[A, B, C, D]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+		cmp.Compare(x.C, y.C),
+		cmp.Compare(x.D, y.D),
+	)
+}
+
+// CompareTuple5 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple5[A, B, C, D, E Ordered](x, y Tuple5[A, B, C, D, E]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+		cmp.Compare(x.C, y.C),
+		cmp.Compare(x.D, y.D),
+		cmp.Compare(x.E, y.E),
+	)
+}
+
+// CompareTuple6 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple6[A, B, C, D, E, F Ordered](x, y Tuple6[A, B, C, D, E, F]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+		cmp.Compare(x.C, y.C),
+		cmp.Compare(x.D, y.D),
+		cmp.Compare(x.E, y.E),
+		cmp.Compare(x.F, y.F),
+	)
+}
+
+// CompareTuple7 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple7[A, B, C, D, E, F, G Ordered](x, y Tuple7[A, B, C, D, E, F, G]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+		cmp.Compare(x.C, y.C),
+		cmp.Compare(x.D, y.D),
+		cmp.Compare(x.E, y.E),
+		cmp.Compare(x.F, y.F),
+		cmp.Compare(x.G, y.G),
+	)
+}
+
+// CompareTuple8 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple8[A, B, C, D, E, F, G, H Ordered](x, y Tuple8[A, B, C, D, E, F, G, H]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+		cmp.Compare(x.C, y.C),
+		cmp.Compare(x.D, y.D),
+		cmp.Compare(x.E, y.E),
+		cmp.Compare(x.F, y.F),
+		cmp.Compare(x.G, y.G),
+		cmp.Compare(x.H, y.H),
+	)
+}
+
+// CompareTuple9 compares two tuples lexicographically: by the first elements, then by the second ones and so on.
+// It returns -1 if x is less than y, 0 if they are equal, and +1 if x is greater than y.
+// It can be used as cmp function, for example with seq.SortComparing or slices.SortFunc.
+func CompareTuple9[A, B, C, D, E, F, G, H, I Ordered](x, y Tuple9[A, B, C, D, E, F, G, H, I]) int {
+	return cmp.Or(
+		cmp.Compare(x.A, y.A),
+		cmp.Compare(x.B, y.B),
+		cmp.Compare(x.C, y.C),
+		cmp.Compare(x.D, y.D),
+		cmp.Compare(x.E, y.E),
+		cmp.Compare(x.F, y.F),
+		cmp.Compare(x.G, y.G),
+		cmp.Compare(x.H, y.H),
+		cmp.Compare(x.I, y.I),
+	)
+}
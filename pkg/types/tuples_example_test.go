@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-softwarelab/common/pkg/seq"
+	"github.com/go-softwarelab/common/pkg/types"
+)
+
+func ExampleTuple2_Unpack() {
+	tuple := types.NewTuple2("answer", 42)
+
+	name, value := tuple.Unpack()
+
+	fmt.Println(name, value)
+	// Output:
+	// answer 42
+}
+
+func ExampleTuple2_ToPair() {
+	pair := types.NewTuple2("answer", 42).ToPair()
+
+	fmt.Println(pair.Left, pair.Right)
+	// Output:
+	// answer 42
+}
+
+func ExampleTuple3_Unpack() {
+	tuple := types.NewTuple3("John", "Doe", 42)
+
+	firstName, lastName, age := tuple.Unpack()
+
+	fmt.Println(firstName, lastName, age)
+	// Output:
+	// John Doe 42
+}
+
+func ExampleCompareTuple2() {
+	tuples := seq.Of(
+		types.NewTuple2("b", 1),
+		types.NewTuple2("a", 2),
+		types.NewTuple2("a", 1),
+	)
+
+	sorted := seq.SortComparing(tuples, types.CompareTuple2[string, int])
+
+	fmt.Println(seq.Collect(sorted))
+	// Output:
+	// [{a 1} {a 2} {b 1}]
+}
+
+func ExampleTuple3_MarshalJSON() {
+	tuple := types.NewTuple3("John", 42, true)
+
+	data, err := json.Marshal(tuple)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(string(data))
+	// Output:
+	// ["John",42,true]
+}
+
+func ExampleTuple3_UnmarshalJSON() {
+	var tuple types.Tuple3[string, int, bool]
+	err := json.Unmarshal([]byte(`["John",42,true]`), &tuple)
+	fmt.Println(tuple, err)
+
+	err = json.Unmarshal([]byte(`["John",42]`), &tuple)
+	fmt.Println(err)
+
+	// Output:
+	// {John 42 true} <nil>
+	// failed to unmarshal tuple: expected array of 3 elements, got 2
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 2 elements.
+func (t Tuple2[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 2 elements.
+func (t *Tuple2[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B)
+}
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 3 elements.
+func (t Tuple3[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 3 elements.
+func (t *Tuple3[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B, &t.C)
+}
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 4 elements.
+func (t Tuple4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C, t.D}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 4 elements.
+func (t *Tuple4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B, &t.C, &t.D)
+}
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 5 elements.
+func (t Tuple5[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C, t.D, t.E}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 5 elements.
+func (t *Tuple5[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B, &t.C, &t.D, &t.E)
+}
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 6 elements.
+func (t Tuple6[A, B, C, D, E, F]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C, t.D, t.E, t.F}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 6 elements.
+func (t *Tuple6[A, B, C, D, E, F]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B, &t.C, &t.D, &t.E, &t.F)
+}
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 7 elements.
+func (t Tuple7[A, B, C, D, E, F, G]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C, t.D, t.E, t.F, t.G}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 7 elements.
+func (t *Tuple7[A, B, C, D, E, F, G]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B, &t.C, &t.D, &t.E, &t.F, &t.G)
+}
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 8 elements.
+func (t Tuple8[A, B, C, D, E, F, G, H]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C, t.D, t.E, t.F, t.G, t.H}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 8 elements.
+func (t *Tuple8[A, B, C, D, E, F, G, H]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B, &t.C, &t.D, &t.E, &t.F, &t.G, &t.H)
+}
+
+// MarshalJSON implements json.Marshaler interface, the tuple is marshaled as a JSON array of 9 elements.
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.A, t.B, t.C, t.D, t.E, t.F, t.G, t.H, t.I}) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface, the tuple is unmarshaled from a JSON array of 9 elements.
+func (t *Tuple9[A, B, C, D, E, F, G, H, I]) UnmarshalJSON(data []byte) error {
+	return unmarshalTuple(data, &t.A, &t.B, &t.C, &t.D, &t.E, &t.F, &t.G, &t.H, &t.I)
+}
+
+func unmarshalTuple(data []byte, targets ...any) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return fmt.Errorf("failed to unmarshal tuple: %w", err)
+	}
+
+	if len(elements) != len(targets) {
+		return fmt.Errorf("failed to unmarshal tuple: expected array of %d elements, got %d", len(targets), len(elements))
+	}
+
+	for i, element := range elements {
+		if err := json.Unmarshal(element, targets[i]); err != nil {
+			return fmt.Errorf("failed to unmarshal tuple element %d: %w", i, err)
+		}
+	}
+	return nil
+}